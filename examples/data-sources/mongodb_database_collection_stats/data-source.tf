@@ -0,0 +1,12 @@
+data "mongodb_database_collection_stats" "countries" {
+  database = "default"
+  name     = "countries"
+}
+
+# Warn when the lookup table grows beyond its expected size
+check "countries_is_small" {
+  assert {
+    condition     = data.mongodb_database_collection_stats.countries.document_count < 1000
+    error_message = "The countries collection has more documents than a lookup table should."
+  }
+}
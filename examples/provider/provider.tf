@@ -7,10 +7,18 @@ terraform {
 }
 
 provider "mongodb" {
-  // You should include valid username and password whose roles have the necessary permissions
-  // for the operations you want to perform in the connection string
+  // You should attach the options as a query string to the connection string
+  // if you want to use it.
   //
-  // Also, you should attach the options as a query string to the connection string
-  // if you want to use it
+  // Every attribute can be omitted in favor of its environment variable
+  // (e.g. MONGODB_URI), which keeps secrets out of the configuration
   uri = "<your-mongodb-connection-string>"
+
+  // Credentials whose roles have the necessary permissions
+  // for the operations you want to perform can be given
+  // either in the connection string or with the attributes below
+  username       = "<your-username>"
+  password       = "<your-password>"
+  auth_source    = "admin"
+  auth_mechanism = "SCRAM-SHA-256"
 }
@@ -7,4 +7,70 @@ resource "mongodb_database_collection" "users" {
   database      = mongodb_database.default.name
   name          = "users"
   force_destroy = false
+
+  # Renaming the collection keeps its documents and indexes
+  rename_in_place = true
+}
+
+resource "mongodb_database_collection" "accounts" {
+  database = mongodb_database.default.name
+  name     = "accounts"
+
+  validator = jsonencode({
+    "$jsonSchema" = {
+      bsonType = "object"
+      required = ["email"]
+      properties = {
+        email = { bsonType = "string" }
+      }
+    }
+  })
+  validation_level  = "moderate"
+  validation_action = "error"
+}
+
+resource "mongodb_database_collection" "audit_log" {
+  database = mongodb_database.default.name
+  name     = "audit_log"
+
+  capped        = true
+  size_bytes    = 104857600
+  max_documents = 100000
+}
+
+resource "mongodb_database_collection" "metrics" {
+  database = mongodb_database.default.name
+  name     = "metrics"
+
+  timeseries {
+    time_field  = "timestamp"
+    meta_field  = "sensor"
+    granularity = "minutes"
+  }
+  expire_after_seconds = 2592000
+}
+
+resource "mongodb_database_collection" "sessions" {
+  database = mongodb_database.default.name
+  name     = "sessions"
+
+  # Clustered collections remove their expired documents without a TTL index
+  clustered_index {}
+  expire_after_seconds = 86400
+
+  change_stream_pre_and_post_images = true
+
+  collation {
+    locale   = "en"
+    strength = 2
+  }
+
+  storage_engine = jsonencode({
+    wiredTiger = { configString = "block_compressor=zstd" }
+  })
+
+  # Other options of the create command
+  options = jsonencode({
+    indexOptionDefaults = { storageEngine = { wiredTiger = {} } }
+  })
 }
@@ -15,5 +15,88 @@ resource "mongodb_database_index" "user_age_index" {
   field         = "age"
   direction     = 1
   unique        = false
+  sparse        = false
+  hidden        = false
+  force_destroy = false
+}
+
+resource "mongodb_database_index" "user_name_age_index" {
+  database   = mongodb_database.default.name
+  collection = mongodb_database_collection.users.name
+  keys = [
+    { field = "last_name", type = "1" },
+    { field = "first_name", type = "1" },
+    { field = "age", type = "-1" },
+  ]
+  force_destroy = false
+}
+
+resource "mongodb_database_collection" "sessions" {
+  database      = mongodb_database.default.name
+  name          = "sessions"
+  force_destroy = false
+}
+
+resource "mongodb_database_index" "session_expiration_index" {
+  database             = mongodb_database.default.name
+  collection           = mongodb_database_collection.sessions.name
+  field                = "last_accessed_at"
+  expire_after_seconds = 86400
+  force_destroy        = false
+}
+
+resource "mongodb_database_index" "user_email_index" {
+  database   = mongodb_database.default.name
+  collection = mongodb_database_collection.users.name
+  field      = "email"
+  unique     = true
+  partial_filter_expression = jsonencode({
+    deleted_at = { "$exists" = false }
+  })
+  force_destroy = false
+}
+
+resource "mongodb_database_collection" "articles" {
+  database      = mongodb_database.default.name
+  name          = "articles"
+  force_destroy = false
+}
+
+resource "mongodb_database_index" "article_search_index" {
+  database   = mongodb_database.default.name
+  collection = mongodb_database_collection.articles.name
+  keys = [
+    { field = "title", type = "text" },
+    { field = "body", type = "text" },
+  ]
+  weights = {
+    title = 10
+  }
+  default_language  = "english"
+  language_override = "language"
+  force_destroy     = false
+}
+
+resource "mongodb_database_collection" "stores" {
+  database      = mongodb_database.default.name
+  name          = "stores"
+  force_destroy = false
+}
+
+resource "mongodb_database_index" "store_location_index" {
+  database   = mongodb_database.default.name
+  collection = mongodb_database_collection.stores.name
+  keys = [
+    { field = "location", type = "2dsphere" },
+    { field = "category", type = "1" },
+  ]
+  sphere_index_version = 3
+  force_destroy        = false
+}
+
+resource "mongodb_database_index" "user_attrs_index" {
+  database      = mongodb_database.default.name
+  collection    = mongodb_database_collection.users.name
+  field         = "attrs.$**"
   force_destroy = false
 }
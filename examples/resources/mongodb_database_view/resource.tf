@@ -0,0 +1,25 @@
+resource "mongodb_database" "default" {
+  name          = "default"
+  force_destroy = false
+}
+
+resource "mongodb_database_collection" "users" {
+  database = mongodb_database.default.name
+  name     = "users"
+}
+
+resource "mongodb_database_view" "public_users" {
+  database = mongodb_database.default.name
+  name     = "public_users"
+  view_on  = mongodb_database_collection.users.name
+
+  pipeline = jsonencode([
+    { "$match" = { deleted = { "$ne" = true } } },
+    { "$project" = { email = 0, password = 0 } },
+  ])
+
+  collation {
+    locale   = "en"
+    strength = 2
+  }
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// NewCollectionTypeMismatch reports a collection managed by a resource
+// of another type, such as a view managed as a regular collection.
+func NewCollectionTypeMismatch(name string, actual string, expected string) *CollectionTypeMismatch {
+	return &CollectionTypeMismatch{
+		name:     name,
+		actual:   actual,
+		expected: expected,
+	}
+}
+
+type CollectionTypeMismatch struct {
+	name     string
+	actual   string
+	expected string
+}
+
+func (e *CollectionTypeMismatch) Error() string {
+	return fmt.Sprintf("Collection %s is a %s, not a %s", e.name, e.actual, e.expected)
+}
+
+func (e *CollectionTypeMismatch) Name() string {
+	return "Collection Type Mismatch"
+}
+
+func (e *CollectionTypeMismatch) ToDiagnostic() diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		e.Name(),
+		e.Error(),
+	)
+}
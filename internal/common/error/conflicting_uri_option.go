@@ -0,0 +1,42 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func NewConflictingURIOption(attribute string, option string) *ConflictingURIOption {
+	return &ConflictingURIOption{
+		attribute: attribute,
+		option:    option,
+	}
+}
+
+type ConflictingURIOption struct {
+	attribute string
+	option    string
+}
+
+func (e *ConflictingURIOption) Error() string {
+	return fmt.Sprintf(
+		"%s is set to a different value in the connection string (%s). "+
+			"Remove it from either the connection string or the provider configuration.",
+		e.attribute,
+		e.option,
+	)
+}
+
+func (e *ConflictingURIOption) Name() string {
+	return "Conflicting URI Option"
+}
+
+func (e *ConflictingURIOption) ToDiagnostic() diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		e.Name(),
+		e.Error(),
+	)
+}
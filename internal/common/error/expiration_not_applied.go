@@ -0,0 +1,39 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// NewExpirationNotApplied reports a TTL index which does not remove
+// some or all of the documents. Its diagnostic is a warning.
+func NewExpirationNotApplied(field string, reason string) *ExpirationNotApplied {
+	return &ExpirationNotApplied{
+		field:  field,
+		reason: reason,
+	}
+}
+
+type ExpirationNotApplied struct {
+	field  string
+	reason string
+}
+
+func (e *ExpirationNotApplied) Error() string {
+	return fmt.Sprintf("Documents may never expire by the TTL index on %s: %s", e.field, e.reason)
+}
+
+func (e *ExpirationNotApplied) Name() string {
+	return "Expiration Not Applied"
+}
+
+func (e *ExpirationNotApplied) ToDiagnostic() diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		e.Name(),
+		e.Error(),
+	)
+}
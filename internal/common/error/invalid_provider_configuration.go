@@ -0,0 +1,36 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import "github.com/hashicorp/terraform-plugin-framework/diag"
+
+// InvalidProviderConfigurationName is the summary of the diagnostics
+// of an invalid provider configuration, including those reported
+// on the attributes of the provider.
+const InvalidProviderConfigurationName = "Invalid Provider Configuration"
+
+func NewInvalidProviderConfiguration(message string) *InvalidProviderConfiguration {
+	return &InvalidProviderConfiguration{
+		message: message,
+	}
+}
+
+type InvalidProviderConfiguration struct {
+	message string
+}
+
+func (e *InvalidProviderConfiguration) Error() string {
+	return e.message
+}
+
+func (e *InvalidProviderConfiguration) Name() string {
+	return InvalidProviderConfigurationName
+}
+
+func (e *InvalidProviderConfiguration) ToDiagnostic() diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		e.Name(),
+		e.Error(),
+	)
+}
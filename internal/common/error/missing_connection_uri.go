@@ -0,0 +1,39 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func NewMissingConnectionURI(env string) *MissingConnectionURI {
+	return &MissingConnectionURI{
+		env: env,
+	}
+}
+
+type MissingConnectionURI struct {
+	env string
+}
+
+func (e *MissingConnectionURI) Error() string {
+	return fmt.Sprintf(
+		"The provider cannot connect to the MongoDB server without a connection string. "+
+			"Set the uri attribute in the provider configuration or the %s environment variable.",
+		e.env,
+	)
+}
+
+func (e *MissingConnectionURI) Name() string {
+	return "Missing Connection URI"
+}
+
+func (e *MissingConnectionURI) ToDiagnostic() diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		e.Name(),
+		e.Error(),
+	)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func NewOperationTimeout(operation string, timeout time.Duration, cause string) *OperationTimeout {
+	return &OperationTimeout{
+		operation: operation,
+		timeout:   timeout,
+		cause:     cause,
+	}
+}
+
+type OperationTimeout struct {
+	operation string
+	timeout   time.Duration
+	cause     string
+}
+
+func (e *OperationTimeout) Error() string {
+	message := fmt.Sprintf(
+		"The %s operation did not complete within %s. "+
+			"Increase the timeout in the timeouts block of the resource "+
+			"or the operation_timeout of the provider if the operation is expected to take longer.",
+		e.operation,
+		e.timeout,
+	)
+	if e.cause != "" {
+		message += "\n\n" + e.cause
+	}
+	return message
+}
+
+func (e *OperationTimeout) Name() string {
+	return "Operation Timeout"
+}
+
+func (e *OperationTimeout) ToDiagnostic() diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		e.Name(),
+		e.Error(),
+	)
+}
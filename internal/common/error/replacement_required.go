@@ -0,0 +1,39 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// NewReplacementRequired reports a change which cannot be applied in place,
+// so that the resource is replaced. Its diagnostic is a warning shown in the plan.
+func NewReplacementRequired(attribute string, reason string) *ReplacementRequired {
+	return &ReplacementRequired{
+		attribute: attribute,
+		reason:    reason,
+	}
+}
+
+type ReplacementRequired struct {
+	attribute string
+	reason    string
+}
+
+func (e *ReplacementRequired) Error() string {
+	return fmt.Sprintf("Changing %s requires the resource to be replaced: %s", e.attribute, e.reason)
+}
+
+func (e *ReplacementRequired) Name() string {
+	return "Replacement Required"
+}
+
+func (e *ReplacementRequired) ToDiagnostic() diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		e.Name(),
+		e.Error(),
+	)
+}
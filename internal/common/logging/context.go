@@ -0,0 +1,31 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+type loggerKey struct{}
+
+// NewContext returns a context carrying the logger, so that the code
+// which receives only the context, such as the driver monitors,
+// logs with the fields of the operation.
+func NewContext(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger carried by the context,
+// or the fallback bound to the context if there is none.
+func FromContext(ctx context.Context, fallback *zap.Logger) *zap.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*zap.Logger); ok {
+		return logger
+	}
+	if fallback == nil {
+		return zap.NewNop()
+	}
+	return WithContext(fallback, ctx)
+}
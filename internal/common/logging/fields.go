@@ -0,0 +1,26 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.uber.org/zap"
+)
+
+// Keys of the fields identifying the target of an operation.
+const (
+	KeyOperation  = "operation"
+	KeyDatabase   = "database"
+	KeyCollection = "collection"
+	KeyID         = "id"
+)
+
+// String returns a field with the value,
+// which is skipped if the value is null, unknown or empty.
+func String(key string, value basetypes.StringValue) zap.Field {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return zap.Skip()
+	}
+	return zap.String(key, value.ValueString())
+}
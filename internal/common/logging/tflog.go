@@ -0,0 +1,144 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// TraceLevel is the level of the entries more verbose than debug,
+// which are written at the trace level of tflog.
+const TraceLevel = zapcore.DebugLevel - 1
+
+// contextCore is implemented by the cores which write
+// to a logger carried by a context.
+type contextCore interface {
+	zapcore.Core
+	withContext(ctx context.Context) zapcore.Core
+}
+
+// Environment variables of the levels of the provider logs, where the variable
+// of the provider, e.g. TF_LOG_PROVIDER_MONGODB, takes precedence over the others.
+const (
+	envTFLog         = "TF_LOG"
+	envTFLogProvider = "TF_LOG_PROVIDER"
+)
+
+// TFLogLevel returns the levels written to tflog by the provider of the type,
+// which are set by the TF_LOG_PROVIDER_<TYPE>, TF_LOG_PROVIDER and TF_LOG
+// environment variables. No level is enabled if none of them is set.
+func TFLogLevel(providerType string) zapcore.LevelEnabler {
+	name := strings.ToUpper(strings.ReplaceAll(providerType, "-", "_"))
+	for _, env := range []string{envTFLogProvider + "_" + name, envTFLogProvider, envTFLog} {
+		value := strings.ToUpper(strings.TrimSpace(os.Getenv(env)))
+		if value == "" {
+			continue
+		}
+
+		switch value {
+		case "OFF":
+			return zapcore.InvalidLevel
+		case "DEBUG":
+			return zapcore.DebugLevel
+		case "INFO":
+			return zapcore.InfoLevel
+		case "WARN":
+			return zapcore.WarnLevel
+		case "ERROR":
+			return zapcore.ErrorLevel
+		default:
+			// Terraform logs everything for JSON and the unknown levels
+			return TraceLevel
+		}
+	}
+	return zapcore.InvalidLevel
+}
+
+// tflogCore writes the entries to the provider logger of tflog,
+// which is filtered by the TF_LOG and TF_LOG_PROVIDER environment variables.
+type tflogCore struct {
+	ctx    context.Context
+	level  zapcore.LevelEnabler
+	fields []zapcore.Field
+}
+
+// NewTFLogCore returns a core writing the entries of the level to the provider
+// logger in the context. The logger of every RPC is set up by the plugin framework,
+// so loggers should be bound to the context of each RPC with WithContext.
+func NewTFLogCore(ctx context.Context, level zapcore.LevelEnabler) zapcore.Core {
+	return &tflogCore{ctx: ctx, level: level}
+}
+
+// WithContext binds the cores of the logger writing to tflog to the context.
+// Other cores are left intact.
+func WithContext(logger *zap.Logger, ctx context.Context) *zap.Logger {
+	return logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		if core, ok := core.(contextCore); ok {
+			return core.withContext(ctx)
+		}
+		return core
+	}))
+}
+
+func (c *tflogCore) withContext(ctx context.Context) zapcore.Core {
+	return &tflogCore{ctx: ctx, level: c.level, fields: c.fields}
+}
+
+// Enabled reports whether the level is written to tflog, so that
+// the fields of the entries left out by tflog are not built at all.
+func (c *tflogCore) Enabled(level zapcore.Level) bool {
+	return c.level.Enabled(level)
+}
+
+func (c *tflogCore) With(fields []zapcore.Field) zapcore.Core {
+	return &tflogCore{
+		ctx:    c.ctx,
+		level:  c.level,
+		fields: append(append([]zapcore.Field{}, c.fields...), fields...),
+	}
+}
+
+func (c *tflogCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *tflogCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range c.fields {
+		field.AddTo(encoder)
+	}
+	for _, field := range fields {
+		field.AddTo(encoder)
+	}
+	if entry.LoggerName != "" {
+		encoder.Fields["logger"] = entry.LoggerName
+	}
+
+	switch entry.Level {
+	case TraceLevel:
+		tflog.Trace(c.ctx, entry.Message, encoder.Fields)
+	case zapcore.DebugLevel:
+		tflog.Debug(c.ctx, entry.Message, encoder.Fields)
+	case zapcore.InfoLevel:
+		tflog.Info(c.ctx, entry.Message, encoder.Fields)
+	case zapcore.WarnLevel:
+		tflog.Warn(c.ctx, entry.Message, encoder.Fields)
+	default:
+		tflog.Error(c.ctx, entry.Message, encoder.Fields)
+	}
+	return nil
+}
+
+func (c *tflogCore) Sync() error {
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestTFLogCore(t *testing.T) {
+	t.Parallel()
+
+	var configured, operation bytes.Buffer
+	logger := logging.WithMask(
+		zap.New(logging.NewTFLogCore(tflogtest.RootLogger(context.Background(), &configured), logging.TraceLevel)),
+		"hunter2",
+	).With(zap.String(logging.KeyDatabase, "db"))
+
+	// Entries go to the logger of the context the logger is bound to
+	ctx := tflogtest.RootLogger(context.Background(), &operation)
+	logging.WithContext(logger, ctx).Warn("retrying", zap.String("reason", "hunter2 rejected"))
+
+	if configured.Len() != 0 {
+		t.Errorf("expected no output to the logger of the configuration, got %q", configured.String())
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&operation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+
+	entry := entries[0]
+	if entry["@level"] != "warn" || entry["@message"] != "retrying" {
+		t.Errorf("unexpected entry: %v", entry)
+	}
+	if entry[logging.KeyDatabase] != "db" {
+		t.Errorf("expected the field of the logger, got %v", entry[logging.KeyDatabase])
+	}
+	if reason, _ := entry["reason"].(string); strings.Contains(reason, "hunter2") {
+		t.Errorf("expected the secret to be masked, got %q", reason)
+	}
+}
+
+func TestTFLogCoreTraceLevel(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+	logger := zap.New(logging.NewTFLogCore(tflogtest.RootLogger(context.Background(), &output), logging.TraceLevel))
+	logger.Log(logging.TraceLevel, "command started")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 || entries[0]["@level"] != "trace" {
+		t.Errorf("expected 1 entry at the trace level, got %v", entries)
+	}
+}
+
+func TestTFLogLevel(t *testing.T) {
+	testCases := []struct {
+		name     string
+		env      map[string]string
+		enabled  []zapcore.Level
+		disabled []zapcore.Level
+	}{
+		{
+			name:     "unset",
+			disabled: []zapcore.Level{logging.TraceLevel, zapcore.ErrorLevel},
+		},
+		{
+			name:     "off",
+			env:      map[string]string{"TF_LOG": "off"},
+			disabled: []zapcore.Level{logging.TraceLevel, zapcore.ErrorLevel},
+		},
+		{
+			name:    "trace",
+			env:     map[string]string{"TF_LOG": "TRACE"},
+			enabled: []zapcore.Level{logging.TraceLevel, zapcore.DebugLevel},
+		},
+		{
+			name:    "json",
+			env:     map[string]string{"TF_LOG": "JSON"},
+			enabled: []zapcore.Level{logging.TraceLevel},
+		},
+		{
+			name:     "provider overrides global",
+			env:      map[string]string{"TF_LOG": "TRACE", "TF_LOG_PROVIDER": "WARN"},
+			enabled:  []zapcore.Level{zapcore.WarnLevel, zapcore.ErrorLevel},
+			disabled: []zapcore.Level{logging.TraceLevel, zapcore.InfoLevel},
+		},
+		{
+			name:     "provider type overrides provider",
+			env:      map[string]string{"TF_LOG_PROVIDER": "ERROR", "TF_LOG_PROVIDER_MONGODB": "DEBUG"},
+			enabled:  []zapcore.Level{zapcore.DebugLevel},
+			disabled: []zapcore.Level{logging.TraceLevel},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			for _, env := range []string{"TF_LOG", "TF_LOG_PROVIDER", "TF_LOG_PROVIDER_MONGODB"} {
+				t.Setenv(env, testCase.env[env])
+			}
+
+			level := logging.TFLogLevel("mongodb")
+			for _, l := range testCase.enabled {
+				if !level.Enabled(l) {
+					t.Errorf("expected %s to be enabled", l)
+				}
+			}
+			for _, l := range testCase.disabled {
+				if level.Enabled(l) {
+					t.Errorf("expected %s to be disabled", l)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"maps"
+
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
+)
+
+// Authentication mechanisms supported by the provider.
+const (
+	AuthMechanismScramSha1   = "SCRAM-SHA-1"
+	AuthMechanismScramSha256 = "SCRAM-SHA-256"
+	AuthMechanismX509        = "MONGODB-X509"
+	AuthMechanismPlain       = "PLAIN"
+)
+
+var AuthMechanisms = []string{
+	AuthMechanismScramSha1,
+	AuthMechanismScramSha256,
+	AuthMechanismX509,
+	AuthMechanismPlain,
+}
+
+// Names of the URI options which can also be set with AuthConfig.
+const (
+	URIOptionUsername                = "username"
+	URIOptionPassword                = "password"
+	URIOptionAuthSource              = "authSource"
+	URIOptionAuthMechanism           = "authMechanism"
+	URIOptionAuthMechanismProperties = "authMechanismProperties"
+)
+
+// AuthConfig holds authentication options given apart from the URI.
+//
+// Empty values are treated as unset, so the corresponding
+// options from the URI (if any) are used instead. Without a Source,
+// the driver authenticates against the database in the URI only if
+// the URI holds the credentials, and against admin otherwise.
+type AuthConfig struct {
+	Username            string
+	Password            string
+	Source              string
+	Mechanism           string
+	MechanismProperties map[string]string
+}
+
+func (a *AuthConfig) IsEmpty() bool {
+	return a == nil || (a.Username == "" &&
+		a.Password == "" &&
+		a.Source == "" &&
+		a.Mechanism == "" &&
+		len(a.MechanismProperties) == 0)
+}
+
+// Conflicts returns the names of the URI options
+// which are also set in the auth config with a different value.
+func (a *AuthConfig) Conflicts(uri string) ([]string, error) {
+	if a.IsEmpty() {
+		return nil, nil
+	}
+
+	cs, err := connstring.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []string
+	if a.Username != "" && cs.UsernameSet && cs.Username != a.Username {
+		conflicts = append(conflicts, URIOptionUsername)
+	}
+	if a.Password != "" && cs.PasswordSet && cs.Password != a.Password {
+		conflicts = append(conflicts, URIOptionPassword)
+	}
+	if a.Source != "" && cs.AuthSourceSet && cs.AuthSource != a.Source {
+		conflicts = append(conflicts, URIOptionAuthSource)
+	}
+	if a.Mechanism != "" && cs.AuthMechanism != "" && cs.AuthMechanism != a.Mechanism {
+		conflicts = append(conflicts, URIOptionAuthMechanism)
+	}
+	if len(a.MechanismProperties) > 0 && cs.AuthMechanismPropertiesSet &&
+		!maps.Equal(cs.AuthMechanismProperties, a.MechanismProperties) {
+		conflicts = append(conflicts, URIOptionAuthMechanismProperties)
+	}
+
+	return conflicts, nil
+}
+
+// apply merges the auth config into the credential built from the URI.
+func (a *AuthConfig) apply(opts *options.ClientOptions, cs *connstring.ConnString) {
+	if a.IsEmpty() {
+		return
+	}
+
+	credential := options.Credential{}
+	if opts.Auth != nil {
+		credential = *opts.Auth
+	}
+
+	if a.Username != "" {
+		credential.Username = a.Username
+	}
+	if a.Password != "" {
+		credential.Password = a.Password
+		credential.PasswordSet = true
+	}
+	if a.Source != "" {
+		credential.AuthSource = a.Source
+	}
+	if a.Mechanism != "" {
+		credential.AuthMechanism = a.Mechanism
+
+		// The auth source parsed from the URI defaults to a value
+		// depending on the mechanism in the URI. Let the driver
+		// pick the default for the new mechanism instead.
+		if a.Source == "" && !cs.AuthSourceSet {
+			credential.AuthSource = ""
+		}
+	}
+	if len(a.MechanismProperties) > 0 {
+		credential.AuthMechanismProperties = a.MechanismProperties
+	}
+
+	opts.SetAuth(credential)
+}
@@ -5,14 +5,97 @@ package mongoclient
 
 import (
 	"context"
+	"slices"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
 	"go.uber.org/zap"
 )
 
 type Config struct {
-	URI string
+	URI  string
+	Auth *AuthConfig
+
+	// TLS takes precedence over the TLS options in the URI.
+	TLS *TLSConfig
+
+	// Proxy is the URL of a SOCKS5 or HTTP proxy to dial the server through.
+	// If SSHTunnel is also set, the bastion host is dialed through the proxy.
+	Proxy string
+
+	// SSHTunnel dials the server through an SSH bastion host if set.
+	SSHTunnel *SSHTunnelConfig
+
+	// Retry configures the retries of operations failed with transient errors.
+	// Operations are not retried if it is nil.
+	Retry *RetryConfig
+
+	// OperationTimeout is the client-side operation timeout of the driver,
+	// which bounds every operation including its retries by the driver.
+	// Operations are bounded only by their contexts if it is zero.
+	OperationTimeout time.Duration
+
+	// Concern configures the read preference, the read concern and the write concern
+	// of the databases and collections. They are taken from the URI if it is nil.
+	Concern *ConcernConfig
+}
+
+// DefaultOperationTimeout is the default timeout of every operation, which bounds
+// the contexts of the operations rather than being given to the driver.
+const DefaultOperationTimeout = 20 * time.Minute
+
+// ClientOptions builds the driver options from the URI
+// and the options given apart from it.
+func (c *Config) ClientOptions() (*options.ClientOptions, error) {
+	cs, err := connstring.ParseAndValidate(c.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Client().ApplyURI(c.URI)
+	c.Auth.apply(opts, cs)
+
+	if c.TLS != nil {
+		tlsConfig, err := c.TLS.build()
+		if err != nil {
+			return nil, err
+		}
+		opts.SetTLSConfig(tlsConfig)
+	}
+
+	if c.OperationTimeout > 0 {
+		opts.SetTimeout(c.OperationTimeout)
+	}
+
+	return opts, nil
+}
+
+// Secrets returns the credentials in the configuration, including
+// the password in the URI, which must not appear in the logs.
+func (c *Config) Secrets() []string {
+	var secrets []string
+	if cs, err := connstring.Parse(c.URI); err == nil {
+		secrets = append(secrets, cs.Password, cs.AuthMechanismProperties["AWS_SESSION_TOKEN"])
+	}
+	if c.Auth != nil {
+		secrets = append(secrets, c.Auth.Password, c.Auth.MechanismProperties["AWS_SESSION_TOKEN"])
+	}
+	if c.Proxy != "" {
+		if u, err := ParseProxyURL(c.Proxy); err == nil && u.User != nil {
+			password, _ := u.User.Password()
+			secrets = append(secrets, password)
+		}
+	}
+	if c.TLS != nil {
+		secrets = append(secrets, string(c.TLS.ClientKey), c.TLS.ClientKeyPassword)
+	}
+	if c.SSHTunnel != nil {
+		secrets = append(secrets, string(c.SSHTunnel.PrivateKey), c.SSHTunnel.PrivateKeyPassword)
+	}
+	return slices.DeleteFunc(secrets, func(secret string) bool { return secret == "" })
 }
 
 type MongoClient struct {
@@ -20,12 +103,20 @@ type MongoClient struct {
 	client *mongo.Client
 	logger *zap.Logger
 	ctx    context.Context
+	retry  *RetryConfig
+	tunnel *SSHTunnel
+
+	// concern is applied to the databases and collections of the client
+	// with the options built from it.
+	concern         *ConcernConfig
+	databaseOptions *options.DatabaseOptions
 }
 
 func New(ctx context.Context, config *Config) *MongoClient {
 	return &MongoClient{
 		config: config,
 		ctx:    ctx,
+		retry:  config.Retry,
 	}
 }
 
@@ -44,20 +135,53 @@ func (c *MongoClient) Context() context.Context {
 }
 
 func (c *MongoClient) Connect() error {
+	opts, err := c.config.ClientOptions()
+	if err != nil {
+		return err
+	}
+	if _, err := c.WithConcern(c.config.Concern); err != nil {
+		return err
+	}
+
+	// Dial through the proxy and the SSH tunnel, if any
+	var dialer options.ContextDialer
+	if c.config.Proxy != "" {
+		dialer, err = NewProxyDialer(c.config.Proxy, nil)
+		if err != nil {
+			return err
+		}
+	}
+	var tunnel *SSHTunnel
+	if c.config.SSHTunnel != nil {
+		tunnel = NewSSHTunnel(c.config.SSHTunnel, dialer)
+		dialer = tunnel
+	}
+	if dialer != nil {
+		opts.SetDialer(dialer)
+	}
+
+	// Trace the commands and the connections
+	opts.SetMonitor(NewCommandMonitor(c.logger))
+	opts.SetPoolMonitor(NewPoolMonitor(c.logger))
+
 	// Create a new client
-	client, err := mongo.Connect(
-		c.ctx,
-		options.Client().ApplyURI(c.config.URI),
-	)
+	client, err := mongo.Connect(c.ctx, opts)
 	if err != nil {
+		closeTunnel(tunnel)
 		return err
 	}
 
 	// Check the connection
-	if err := client.Ping(c.ctx, nil); err != nil {
+	err = Retry(c.ctx, c.retry, c.logger, "ping", func() error {
+		return client.Ping(c.ctx, nil)
+	})
+	if err != nil {
+		_ = client.Disconnect(c.ctx)
+		closeTunnel(tunnel)
 		return err
 	}
 	c.client = client
+	c.tunnel = tunnel
 	return nil
 }
 
@@ -65,9 +189,28 @@ func (c *MongoClient) Disconnect() {
 	// We don't handle the error here because we are disconnecting
 	// the client anyway.
 	_ = c.client.Disconnect(c.ctx)
+	closeTunnel(c.tunnel)
 
 	// Set the client to nil
 	c.client = nil
+	c.tunnel = nil
+}
+
+// ListDatabaseNames returns the names of every database on the server.
+func (c *MongoClient) ListDatabaseNames() ([]string, error) {
+	var names []string
+	err := Retry(c.ctx, c.retry, c.logger, "list databases", func() error {
+		var err error
+		names, err = c.client.ListDatabaseNames(c.ctx, bson.D{})
+		return err
+	})
+	return names, err
+}
+
+func closeTunnel(tunnel *SSHTunnel) {
+	if tunnel != nil {
+		_ = tunnel.Close()
+	}
 }
 
 func (c *MongoClient) IsConnected() bool {
@@ -84,6 +227,20 @@ func (c *MongoClient) WithLogger(logger *zap.Logger) *MongoClient {
 	return c
 }
 
+// WithConcern applies the concern to the databases and collections of the client,
+// taking precedence over the options set in the concern of the client so far.
+func (c *MongoClient) WithConcern(concern *ConcernConfig) (*MongoClient, error) {
+	merged := c.concern.Merge(concern)
+	databaseOptions, err := merged.DatabaseOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	c.concern = merged
+	c.databaseOptions = databaseOptions
+	return c, nil
+}
+
 func (c *MongoClient) Run(callback func(client *MongoClient, err error)) {
 	err := c.Connect()
 	callback(c, err)
@@ -0,0 +1,39 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import "go.mongodb.org/mongo-driver/mongo/options"
+
+// Collation is the collation of a collection, a view or an index,
+// as reported by the server. Unlike options.Collation,
+// it decodes the fields named in camel case.
+type Collation struct {
+	Locale          string `bson:"locale"`
+	CaseLevel       bool   `bson:"caseLevel,omitempty"`
+	CaseFirst       string `bson:"caseFirst,omitempty"`
+	Strength        int    `bson:"strength,omitempty"`
+	NumericOrdering bool   `bson:"numericOrdering,omitempty"`
+	Alternate       string `bson:"alternate,omitempty"`
+	MaxVariable     string `bson:"maxVariable,omitempty"`
+	Normalization   bool   `bson:"normalization,omitempty"`
+	Backwards       bool   `bson:"backwards,omitempty"`
+}
+
+func (c *Collation) options() *options.Collation {
+	if c == nil {
+		return nil
+	}
+
+	return &options.Collation{
+		Locale:          c.Locale,
+		CaseLevel:       c.CaseLevel,
+		CaseFirst:       c.CaseFirst,
+		Strength:        c.Strength,
+		NumericOrdering: c.NumericOrdering,
+		Alternate:       c.Alternate,
+		MaxVariable:     c.MaxVariable,
+		Normalization:   c.Normalization,
+		Backwards:       c.Backwards,
+	}
+}
@@ -14,23 +14,27 @@ import (
 )
 
 type Collection struct {
+	owner      *MongoClient
 	name       string
 	client     *mongo.Client
 	database   *mongo.Database
 	collection *mongo.Collection
 	ctx        context.Context
 	logger     *zap.Logger
+	retry      *RetryConfig
 }
 
 func (d *Database) Collection(name string) *Collection {
 	collection := d.database.Collection(name)
 	return &Collection{
+		owner:      d.owner,
 		name:       name,
 		client:     d.client,
 		database:   d.database,
 		collection: collection,
 		ctx:        d.ctx,
 		logger:     d.logger,
+		retry:      d.retry,
 	}
 }
 
@@ -44,11 +48,13 @@ func (c *Collection) Client() *MongoClient {
 
 func (c *Collection) Database() *Database {
 	return &Database{
+		owner:    c.owner,
 		name:     c.database.Name(),
 		client:   c.client,
 		database: c.database,
 		ctx:      c.ctx,
 		logger:   c.logger,
+		retry:    c.retry,
 	}
 }
 
@@ -62,7 +68,22 @@ func (c *Collection) WithLogger(logger *zap.Logger) *Collection {
 	return c
 }
 
+// withRetry calls the operation with the retries of the collection.
+func (c *Collection) withRetry(operation string, fn func() error) error {
+	return Retry(c.ctx, c.retry, c.logger, operation, fn)
+}
+
 func (c *Collection) Exists() (bool, error) {
+	var exists bool
+	err := c.withRetry("list collections", func() error {
+		var err error
+		exists, err = c.exists()
+		return err
+	})
+	return exists, err
+}
+
+func (c *Collection) exists() (bool, error) {
 	names, err := c.database.ListCollectionNames(
 		c.ctx,
 		bson.D{{Key: "name", Value: c.name}},
@@ -75,8 +96,12 @@ func (c *Collection) Exists() (bool, error) {
 }
 
 func (c *Collection) EnsureExistance() error {
+	return c.Create(nil)
+}
+
+func (c *Collection) ensureExistance(opts *CollectionOptions) error {
 	// Check if the collection exists
-	exists, err := c.Exists()
+	exists, err := c.exists()
 	if err != nil {
 		return err
 	}
@@ -84,8 +109,13 @@ func (c *Collection) EnsureExistance() error {
 		return nil
 	}
 
-	// Create the collection
-	if err := c.database.CreateCollection(c.ctx, c.name); err != nil {
+	// Create the collection with the create command,
+	// which takes the options without a counterpart in the driver
+	command, err := opts.createCommand(c.name)
+	if err != nil {
+		return err
+	}
+	if err := c.database.RunCommand(c.ctx, command).Err(); err != nil {
 		return err
 	}
 
@@ -93,11 +123,25 @@ func (c *Collection) EnsureExistance() error {
 }
 
 func (c *Collection) Drop() error {
-	return c.collection.Drop(c.ctx)
+	retried := false
+	return c.withRetry("drop collection", func() error {
+		err := c.collection.Drop(c.ctx)
+		// A previous attempt may have dropped the collection
+		if retried && hasErrorCode(err, errorCodeNamespaceNotFound) {
+			return nil
+		}
+		retried = true
+		return err
+	})
 }
 
 func (c *Collection) IsEmpty() (bool, error) {
-	count, err := c.collection.CountDocuments(c.ctx, bson.D{})
+	var count int64
+	err := c.withRetry("count documents", func() error {
+		var err error
+		count, err = c.collection.CountDocuments(c.ctx, bson.D{})
+		return err
+	})
 	if err != nil {
 		return false, err
 	}
@@ -111,10 +155,21 @@ func (c *Collection) Find(filter Document) (Documents, error) {
 		return nil, err
 	}
 
-	cursor, err := c.collection.Find(c.ctx, bsonFilter)
+	var documents Documents
+	err = c.withRetry("find documents", func() error {
+		var err error
+		documents, err = c.find(bsonFilter)
+		return err
+	})
+	return documents, err
+}
+
+func (c *Collection) find(filter bson.D) (Documents, error) {
+	cursor, err := c.collection.Find(c.ctx, filter)
 	if err != nil {
 		return nil, err
 	}
+	defer cursor.Close(c.ctx)
 
 	var documents Documents
 
@@ -146,7 +201,11 @@ func (c *Collection) FindById(id string, opts *FindByIdOptions) (Document, error
 	// Retrieve the document
 	var document Document
 	filter := bson.D{{Key: "_id", Value: oid}}
-	if err := c.collection.FindOne(c.ctx, filter).Decode(&document); err != nil {
+	err = c.withRetry("find document", func() error {
+		document = nil
+		return c.collection.FindOne(c.ctx, filter).Decode(&document)
+	})
+	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, nil
 		}
@@ -168,12 +227,40 @@ func (c *Collection) InsertOne(document Document) (string, error) {
 		return "", err
 	}
 
-	res, err := c.collection.InsertOne(c.ctx, bsonDoc)
+	// Generate the id on our side, so that the retries insert the same document.
+	// Otherwise, the driver generates a new id for each attempt and a retry
+	// may insert a duplicate if the previous attempt has taken effect.
+	var insertedID interface{}
+	for _, element := range bsonDoc {
+		if element.Key == "_id" {
+			insertedID = element.Value
+			break
+		}
+	}
+	if insertedID == nil {
+		insertedID = primitive.NewObjectID()
+		bsonDoc = append(bson.D{{Key: "_id", Value: insertedID}}, bsonDoc...)
+	}
+
+	attempt := 0
+	err = c.withRetry("insert document", func() error {
+		attempt++
+		_, err := c.collection.InsertOne(c.ctx, bsonDoc)
+
+		// The previous attempt may have inserted the document
+		if attempt > 1 && mongo.IsDuplicateKeyError(err) {
+			count, countErr := c.collection.CountDocuments(c.ctx, bson.D{{Key: "_id", Value: insertedID}})
+			if countErr == nil && count > 0 {
+				return nil
+			}
+		}
+		return err
+	})
 	if err != nil {
 		return "", err
 	}
 
-	oid, ok := res.InsertedID.(primitive.ObjectID)
+	oid, ok := insertedID.(primitive.ObjectID)
 	if !ok {
 		return "", errors.New("failed to convert InsertedID to ObjectID")
 	}
@@ -192,8 +279,10 @@ func (c *Collection) UpdateByID(id string, update Document) error {
 	}
 
 	filter := bson.D{{Key: "_id", Value: oid}}
-	_, err = c.collection.UpdateOne(c.ctx, filter, bson.D{{Key: "$set", Value: bsonDoc}})
-	return err
+	return c.withRetry("update document", func() error {
+		_, err := c.collection.UpdateOne(c.ctx, filter, bson.D{{Key: "$set", Value: bsonDoc}})
+		return err
+	})
 }
 
 func (c *Collection) DeleteByID(id string) error {
@@ -202,6 +291,8 @@ func (c *Collection) DeleteByID(id string) error {
 		return err
 	}
 	filter := bson.D{{Key: "_id", Value: oid}}
-	_, err = c.collection.DeleteOne(c.ctx, filter)
-	return err
+	return c.withRetry("delete document", func() error {
+		_, err := c.collection.DeleteOne(c.ctx, filter)
+		return err
+	})
 }
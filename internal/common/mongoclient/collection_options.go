@@ -0,0 +1,343 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"encoding/hex"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Validation levels and actions of the collections.
+const (
+	ValidationLevelOff      = "off"
+	ValidationLevelStrict   = "strict"
+	ValidationLevelModerate = "moderate"
+
+	ValidationActionError = "error"
+	ValidationActionWarn  = "warn"
+)
+
+var (
+	ValidationLevels  = []string{ValidationLevelOff, ValidationLevelStrict, ValidationLevelModerate}
+	ValidationActions = []string{ValidationActionError, ValidationActionWarn}
+)
+
+// Types of the collections reported by the listCollections command.
+const (
+	CollectionTypeCollection = "collection"
+	CollectionTypeView       = "view"
+	CollectionTypeTimeseries = "timeseries"
+)
+
+var CollectionTypes = []string{CollectionTypeCollection, CollectionTypeView, CollectionTypeTimeseries}
+
+// CollectionOptions are the options of a collection,
+// as reported by the listCollections command.
+type CollectionOptions struct {
+	// Type is the type of the collection, which is not an option
+	// but is reported along with them.
+	Type string `bson:"-"`
+
+	// Validator is the document validating the documents of the collection,
+	// which is usually a $jsonSchema expression.
+	Validator        bson.Raw `bson:"validator,omitempty"`
+	ValidationLevel  string   `bson:"validationLevel,omitempty"`
+	ValidationAction string   `bson:"validationAction,omitempty"`
+
+	// Capped collections have a fixed size in bytes and optionally a maximum
+	// number of documents, beyond which the oldest documents are removed.
+	Capped bool  `bson:"capped,omitempty"`
+	Size   int64 `bson:"size,omitempty"`
+	Max    int64 `bson:"max,omitempty"`
+
+	// TimeSeries is set for time-series collections.
+	TimeSeries *TimeSeriesOptions `bson:"timeseries,omitempty"`
+
+	// ExpireAfterSeconds is the number of seconds after which the documents
+	// of a time-series collection are removed. Documents never expire if it is zero.
+	ExpireAfterSeconds int64 `bson:"expireAfterSeconds,omitempty"`
+
+	// ViewOn and Pipeline are set for views.
+	ViewOn   string `bson:"viewOn,omitempty"`
+	Pipeline bson.A `bson:"pipeline,omitempty"`
+
+	Collation *Collation `bson:"collation,omitempty"`
+
+	// ClusteredIndex is set for clustered collections, whose documents
+	// are stored in the order of their _id. Time-series collections
+	// report a clustered index without its options, which is not read.
+	ClusteredIndex *ClusteredIndexOptions `bson:"-"`
+
+	ChangeStreamPreAndPostImages *ChangeStreamPreAndPostImagesOptions `bson:"changeStreamPreAndPostImages,omitempty"`
+
+	// StorageEngine is the configuration of the storage engine for the collection,
+	// which is passed to the storage engine as it is.
+	StorageEngine bson.Raw `bson:"storageEngine,omitempty"`
+
+	// Extra are the options without a field in CollectionOptions,
+	// which are passed to the create command as they are.
+	Extra bson.Raw `bson:"-"`
+
+	// Raw is the whole options document reported by the server.
+	Raw bson.Raw `bson:"-"`
+
+	// ReadOnly and UUID are the information about the collection
+	// reported along with the options.
+	ReadOnly bool   `bson:"-"`
+	UUID     string `bson:"-"`
+}
+
+// ClusteredIndexOptions are the options of the clustered index,
+// whose key is always the _id field.
+type ClusteredIndexOptions struct {
+	Name string
+}
+
+// ChangeStreamPreAndPostImagesOptions tell whether the change streams
+// report the documents before and after the changes.
+type ChangeStreamPreAndPostImagesOptions struct {
+	Enabled bool `bson:"enabled"`
+}
+
+// TypedCollectionOptions are the names of the options with a field in CollectionOptions.
+var TypedCollectionOptions = []string{
+	"validator", "validationLevel", "validationAction",
+	"capped", "size", "max",
+	"timeseries", "expireAfterSeconds",
+	"viewOn", "pipeline",
+	"collation", "clusteredIndex", "changeStreamPreAndPostImages", "storageEngine",
+}
+
+// Granularities of the time-series collections, from the finest to the coarsest.
+const (
+	GranularitySeconds = "seconds"
+	GranularityMinutes = "minutes"
+	GranularityHours   = "hours"
+)
+
+var Granularities = []string{GranularitySeconds, GranularityMinutes, GranularityHours}
+
+// TimeSeriesOptions are the options of a time-series collection.
+// The documents are bucketed either by the granularity or by the custom
+// bucket max span and rounding, which must be equal.
+type TimeSeriesOptions struct {
+	TimeField             string `bson:"timeField"`
+	MetaField             string `bson:"metaField,omitempty"`
+	Granularity           string `bson:"granularity,omitempty"`
+	BucketMaxSpanSeconds  int64  `bson:"bucketMaxSpanSeconds,omitempty"`
+	BucketRoundingSeconds int64  `bson:"bucketRoundingSeconds,omitempty"`
+}
+
+// MinServerVersionToResize is the earliest version of the server
+// resizing the capped collections with the collMod command.
+var MinServerVersionToResize = ServerVersion{Major: 6, Minor: 0}
+
+// CappedSizeUnit is the unit the size of the capped collections
+// is rounded up to by the server.
+const CappedSizeUnit = 256
+
+// CappedSize returns the size of a capped collection created with the size,
+// which is rounded up to a multiple of CappedSizeUnit.
+func CappedSize(size int64) int64 {
+	if remainder := size % CappedSizeUnit; remainder != 0 {
+		return size + CappedSizeUnit - remainder
+	}
+	return size
+}
+
+// Options returns the options of the collection,
+// or nil if the collection does not exist.
+func (c *Collection) Options() (*CollectionOptions, error) {
+	var opts *CollectionOptions
+	err := c.withRetry("list collections", func() error {
+		specs, err := c.database.ListCollectionSpecifications(
+			c.ctx,
+			bson.D{{Key: "name", Value: c.name}},
+		)
+		if err != nil {
+			return err
+		}
+		if len(specs) == 0 {
+			opts = nil
+			return nil
+		}
+
+		spec := specs[0]
+		opts = &CollectionOptions{
+			Type:     spec.Type,
+			Raw:      spec.Options,
+			ReadOnly: spec.ReadOnly,
+			UUID:     formatUUID(spec.UUID),
+		}
+		if err := bson.Unmarshal(spec.Options, opts); err != nil {
+			return err
+		}
+		if clusteredIndex, ok := spec.Options.Lookup("clusteredIndex").DocumentOK(); ok {
+			name, _ := clusteredIndex.Lookup("name").StringValueOK()
+			opts.ClusteredIndex = &ClusteredIndexOptions{Name: name}
+		}
+
+		// collMod leaves an empty validator when the validator is removed
+		if elements, err := opts.Validator.Elements(); err == nil && len(elements) == 0 {
+			opts.Validator = nil
+		}
+		return nil
+	})
+	return opts, err
+}
+
+// Create creates the collection with the options if it does not exist.
+// The options are not applied to an existing collection.
+func (c *Collection) Create(opts *CollectionOptions) error {
+	return c.withRetry("create collection", func() error {
+		return c.ensureExistance(opts)
+	})
+}
+
+// Modify applies the options to the collection with the collMod command.
+// The validator of the collection is removed if the validator is not set,
+// while the validation level and action are left intact if they are not set.
+func (c *Collection) Modify(opts *CollectionOptions) error {
+	command := bson.D{{Key: "collMod", Value: c.name}}
+	if opts.Validator != nil {
+		command = append(command, bson.E{Key: "validator", Value: opts.Validator})
+	} else {
+		command = append(command, bson.E{Key: "validator", Value: bson.D{}})
+	}
+	if opts.ValidationLevel != "" {
+		command = append(command, bson.E{Key: "validationLevel", Value: opts.ValidationLevel})
+	}
+	if opts.ValidationAction != "" {
+		command = append(command, bson.E{Key: "validationAction", Value: opts.ValidationAction})
+	}
+
+	return c.withRetry("modify collection", func() error {
+		return c.database.RunCommand(c.ctx, command).Err()
+	})
+}
+
+// Resize changes the size and the maximum number of documents of the capped collection
+// with the collMod command, which is supported by MinServerVersionToResize or later.
+// The maximum number of documents is removed if max is zero.
+func (c *Collection) Resize(size int64, max int64) error {
+	command := bson.D{
+		{Key: "collMod", Value: c.name},
+		{Key: "cappedSize", Value: size},
+		{Key: "cappedMax", Value: max},
+	}
+
+	return c.withRetry("resize collection", func() error {
+		return c.database.RunCommand(c.ctx, command).Err()
+	})
+}
+
+// ModifyTimeSeries changes the bucketing of the time-series collection with the collMod command.
+// The granularity can only be made coarser, and the custom bucket max span and rounding
+// can only be increased. The time and meta fields cannot be changed.
+func (c *Collection) ModifyTimeSeries(opts *TimeSeriesOptions) error {
+	timeseries := bson.D{}
+	if opts.Granularity != "" {
+		timeseries = append(timeseries, bson.E{Key: "granularity", Value: opts.Granularity})
+	} else {
+		timeseries = append(timeseries,
+			bson.E{Key: "bucketMaxSpanSeconds", Value: opts.BucketMaxSpanSeconds},
+			bson.E{Key: "bucketRoundingSeconds", Value: opts.BucketRoundingSeconds},
+		)
+	}
+	command := bson.D{
+		{Key: "collMod", Value: c.name},
+		{Key: "timeseries", Value: timeseries},
+	}
+
+	return c.withRetry("modify time-series collection", func() error {
+		return c.database.RunCommand(c.ctx, command).Err()
+	})
+}
+
+// SetExpireAfterSeconds changes the number of seconds after which the documents
+// of the collection are removed with the collMod command.
+// The documents never expire if seconds is zero.
+func (c *Collection) SetExpireAfterSeconds(seconds int64) error {
+	var value interface{} = seconds
+	if seconds == 0 {
+		value = "off"
+	}
+	command := bson.D{
+		{Key: "collMod", Value: c.name},
+		{Key: "expireAfterSeconds", Value: value},
+	}
+
+	return c.withRetry("modify collection expiration", func() error {
+		return c.database.RunCommand(c.ctx, command).Err()
+	})
+}
+
+// SetChangeStreamPreAndPostImages enables or disables the pre- and post-images
+// of the change streams on the collection with the collMod command.
+func (c *Collection) SetChangeStreamPreAndPostImages(enabled bool) error {
+	command := bson.D{
+		{Key: "collMod", Value: c.name},
+		{Key: "changeStreamPreAndPostImages", Value: ChangeStreamPreAndPostImagesOptions{Enabled: enabled}},
+	}
+
+	return c.withRetry("modify change stream images", func() error {
+		return c.database.RunCommand(c.ctx, command).Err()
+	})
+}
+
+// createCommand returns the create command creating the collection with the options.
+// The extra options are appended unless they have a field in CollectionOptions.
+func (o *CollectionOptions) createCommand(name string) (bson.D, error) {
+	command := bson.D{{Key: "create", Value: name}}
+	if o == nil {
+		return command, nil
+	}
+
+	encoded, err := bson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	var typed bson.D
+	if err := bson.Unmarshal(encoded, &typed); err != nil {
+		return nil, err
+	}
+	command = append(command, typed...)
+
+	if o.ClusteredIndex != nil {
+		clusteredIndex := bson.D{
+			{Key: "key", Value: bson.D{{Key: "_id", Value: 1}}},
+			{Key: "unique", Value: true},
+		}
+		if o.ClusteredIndex.Name != "" {
+			clusteredIndex = append(clusteredIndex, bson.E{Key: "name", Value: o.ClusteredIndex.Name})
+		}
+		command = append(command, bson.E{Key: "clusteredIndex", Value: clusteredIndex})
+	}
+
+	elements, err := o.Extra.Elements()
+	if err != nil {
+		return nil, err
+	}
+	for _, element := range elements {
+		if slices.Contains(TypedCollectionOptions, element.Key()) {
+			continue
+		}
+		command = append(command, bson.E{Key: element.Key(), Value: element.Value()})
+	}
+
+	return command, nil
+}
+
+// formatUUID formats the UUID of a collection in its canonical form.
+func formatUUID(uuid *primitive.Binary) string {
+	if uuid == nil || len(uuid.Data) != 16 {
+		return ""
+	}
+	encoded := hex.EncodeToString(uuid.Data)
+	return strings.Join([]string{encoded[0:8], encoded[8:12], encoded[12:16], encoded[16:20], encoded[20:]}, "-")
+}
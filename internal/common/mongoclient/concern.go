@@ -0,0 +1,163 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+	"go.mongodb.org/mongo-driver/tag"
+)
+
+// Read preference modes supported by the server.
+const (
+	ReadPreferencePrimary            = "primary"
+	ReadPreferencePrimaryPreferred   = "primaryPreferred"
+	ReadPreferenceSecondary          = "secondary"
+	ReadPreferenceSecondaryPreferred = "secondaryPreferred"
+	ReadPreferenceNearest            = "nearest"
+)
+
+var ReadPreferenceModes = []string{
+	ReadPreferencePrimary,
+	ReadPreferencePrimaryPreferred,
+	ReadPreferenceSecondary,
+	ReadPreferenceSecondaryPreferred,
+	ReadPreferenceNearest,
+}
+
+// Read concern levels supported by the server.
+var ReadConcernLevels = []string{
+	"local",
+	"available",
+	"majority",
+	"linearizable",
+	"snapshot",
+}
+
+// WriteConcernMajority requests acknowledgment from the majority of the replica set members.
+const WriteConcernMajority = "majority"
+
+// ConcernConfig configures the read preference, the read concern
+// and the write concern of the operations on databases and collections.
+// Unset options are inherited from the client, which takes them from the URI.
+type ConcernConfig struct {
+	ReadPreference *ReadPreferenceConfig
+
+	// ReadConcern is the level of the read concern, e.g. majority.
+	ReadConcern string
+
+	WriteConcern *WriteConcernConfig
+}
+
+type ReadPreferenceConfig struct {
+	// Mode is one of ReadPreferenceModes.
+	Mode string
+
+	// TagSets are tried in order until a member matching
+	// every tag of a set is found. An empty set matches any member.
+	TagSets []map[string]string
+
+	// MaxStaleness excludes secondaries lagging behind the primary
+	// for longer than it. Zero means no maximum.
+	MaxStaleness time.Duration
+}
+
+type WriteConcernConfig struct {
+	// W is the number of members, "majority" or the name
+	// of a custom write concern which must acknowledge writes.
+	W string
+
+	// Journal requests acknowledgment that writes are written to the on-disk journal.
+	Journal *bool
+}
+
+// Merge returns the configuration with the options set in the override
+// taking precedence. Either of them may be nil.
+func (c *ConcernConfig) Merge(override *ConcernConfig) *ConcernConfig {
+	if c == nil {
+		return override
+	}
+	if override == nil {
+		return c
+	}
+
+	merged := *c
+	if override.ReadPreference != nil {
+		merged.ReadPreference = override.ReadPreference
+	}
+	if override.ReadConcern != "" {
+		merged.ReadConcern = override.ReadConcern
+	}
+	if override.WriteConcern != nil {
+		merged.WriteConcern = override.WriteConcern
+	}
+	return &merged
+}
+
+// DatabaseOptions builds the options of the database handles.
+// It returns nil if c is nil.
+func (c *ConcernConfig) DatabaseOptions() (*options.DatabaseOptions, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	opts := options.Database()
+	if c.ReadPreference != nil {
+		readPreference, err := c.ReadPreference.build()
+		if err != nil {
+			return nil, err
+		}
+		opts.SetReadPreference(readPreference)
+	}
+	if c.ReadConcern != "" {
+		opts.SetReadConcern(readconcern.New(readconcern.Level(c.ReadConcern)))
+	}
+	if c.WriteConcern != nil {
+		opts.SetWriteConcern(c.WriteConcern.build())
+	}
+	return opts, nil
+}
+
+func (c *ReadPreferenceConfig) build() (*readpref.ReadPref, error) {
+	mode, err := readpref.ModeFromString(c.Mode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid read preference: %w", err)
+	}
+
+	var opts []readpref.Option
+	if len(c.TagSets) > 0 {
+		opts = append(opts, readpref.WithTagSets(tag.NewTagSetsFromMaps(c.TagSets)...))
+	}
+	if c.MaxStaleness > 0 {
+		opts = append(opts, readpref.WithMaxStaleness(c.MaxStaleness))
+	}
+
+	readPreference, err := readpref.New(mode, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid read preference: %w", err)
+	}
+	return readPreference, nil
+}
+
+func (c *WriteConcernConfig) build() *writeconcern.WriteConcern {
+	var w interface{}
+	if n, err := strconv.Atoi(c.W); err == nil {
+		w = n
+	} else if c.W != "" {
+		w = c.W
+	}
+
+	// The wait for the acknowledgment is bounded by the operation timeout,
+	// since the driver does not support wtimeout along with it.
+	return &writeconcern.WriteConcern{
+		W:       w,
+		Journal: c.Journal,
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+type ConcernDatabaseOptionsTestCase struct {
+	name      string
+	concern   *mongoclient.ConcernConfig
+	expectErr bool
+}
+
+func TestConcernDatabaseOptions(t *testing.T) {
+	t.Parallel()
+
+	journal := true
+	tests := []ConcernDatabaseOptionsTestCase{
+		{
+			name: "nil",
+		},
+		{
+			name: "full",
+			concern: &mongoclient.ConcernConfig{
+				ReadPreference: &mongoclient.ReadPreferenceConfig{
+					Mode:         mongoclient.ReadPreferenceSecondaryPreferred,
+					TagSets:      []map[string]string{{"region": "east"}, {}},
+					MaxStaleness: 2 * time.Minute,
+				},
+				ReadConcern: "majority",
+				WriteConcern: &mongoclient.WriteConcernConfig{
+					W:       mongoclient.WriteConcernMajority,
+					Journal: &journal,
+				},
+			},
+		},
+		{
+			name: "numeric-w",
+			concern: &mongoclient.ConcernConfig{
+				WriteConcern: &mongoclient.WriteConcernConfig{W: "2"},
+			},
+		},
+		{
+			name: "unknown-mode",
+			concern: &mongoclient.ConcernConfig{
+				ReadPreference: &mongoclient.ReadPreferenceConfig{Mode: "fastest"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "primary-with-tag-sets",
+			concern: &mongoclient.ConcernConfig{
+				ReadPreference: &mongoclient.ReadPreferenceConfig{
+					Mode:    mongoclient.ReadPreferencePrimary,
+					TagSets: []map[string]string{{"region": "east"}},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			opts, err := testCase.concern.DatabaseOptions()
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if testCase.concern == nil && opts != nil {
+				t.Errorf("expected no options, got %v", opts)
+			}
+		})
+	}
+}
+
+func TestConcernMerge(t *testing.T) {
+	t.Parallel()
+
+	base := &mongoclient.ConcernConfig{
+		ReadPreference: &mongoclient.ReadPreferenceConfig{Mode: mongoclient.ReadPreferenceSecondary},
+		ReadConcern:    "local",
+		WriteConcern:   &mongoclient.WriteConcernConfig{W: "1"},
+	}
+	override := &mongoclient.ConcernConfig{
+		ReadConcern: "majority",
+	}
+
+	merged := base.Merge(override)
+	if merged.ReadConcern != "majority" {
+		t.Errorf("expected the read concern of the override, got %q", merged.ReadConcern)
+	}
+	if merged.ReadPreference != base.ReadPreference || merged.WriteConcern != base.WriteConcern {
+		t.Errorf("expected the options unset in the override to be kept, got %+v", merged)
+	}
+	if base.ReadConcern != "local" {
+		t.Errorf("expected the base to be left intact, got %q", base.ReadConcern)
+	}
+
+	opts, err := merged.DatabaseOptions()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.ReadPreference.Mode() != readpref.SecondaryMode {
+		t.Errorf("expected the secondary mode, got %v", opts.ReadPreference.Mode())
+	}
+
+	var empty *mongoclient.ConcernConfig
+	if empty.Merge(override) != override || base.Merge(nil) != base {
+		t.Error("expected a nil configuration to be ignored")
+	}
+}
@@ -14,24 +14,35 @@ import (
 const (
 	// Placeholder collection name for explicit database creation.
 	PlaceholderCollectionName = "__terraform_provider_mongodb"
+
+	// AdminDatabaseName is the name of the database running the administrative commands.
+	AdminDatabaseName = "admin"
+	// ConfigDatabaseName is the name of the database holding the metadata of a sharded cluster.
+	ConfigDatabaseName = "config"
 )
 
 type Database struct {
+	// owner is the client the database is taken from,
+	// carrying the concern and the options of the client.
+	owner    *MongoClient
 	name     string
 	client   *mongo.Client
 	database *mongo.Database
 	ctx      context.Context
 	logger   *zap.Logger
+	retry    *RetryConfig
 }
 
 func (c *MongoClient) Database(name string) *Database {
-	database := c.client.Database(name)
+	database := c.client.Database(name, c.databaseOptions)
 	return &Database{
+		owner:    c,
 		name:     name,
 		client:   c.client,
 		database: database,
 		ctx:      c.ctx,
 		logger:   c.logger,
+		retry:    c.retry,
 	}
 }
 
@@ -39,13 +50,14 @@ func (d *Database) Name() string {
 	return d.name
 }
 
+// Client returns the client the database is taken from, with the context
+// and the logger of the database. The concern and the options of the client are kept.
 func (d *Database) Client() *MongoClient {
-	return &MongoClient{
-		config: nil,
-		client: d.client,
-		ctx:    d.ctx,
-		logger: d.logger,
-	}
+	client := *d.owner
+	client.ctx = d.ctx
+	client.logger = d.logger
+	client.retry = d.retry
+	return &client
 }
 
 func (d *Database) WithContext(ctx context.Context) *Database {
@@ -58,9 +70,18 @@ func (d *Database) WithLogger(logger *zap.Logger) *Database {
 	return d
 }
 
+// withRetry calls the operation with the retries of the database.
+func (d *Database) withRetry(operation string, fn func() error) error {
+	return Retry(d.ctx, d.retry, d.logger, operation, fn)
+}
+
 func (d *Database) EnsureExistance() error {
+	return d.withRetry("create database", d.ensureExistance)
+}
+
+func (d *Database) ensureExistance() error {
 	// Check if the database exists
-	exists, err := d.Exists()
+	exists, err := d.exists()
 	if err != nil {
 		return err
 	}
@@ -77,6 +98,16 @@ func (d *Database) EnsureExistance() error {
 }
 
 func (d *Database) Exists() (bool, error) {
+	var exists bool
+	err := d.withRetry("list databases", func() error {
+		var err error
+		exists, err = d.exists()
+		return err
+	})
+	return exists, err
+}
+
+func (d *Database) exists() (bool, error) {
 	names, err := d.client.ListDatabaseNames(d.ctx, bson.D{})
 	if err != nil {
 		return false, err
@@ -92,11 +123,41 @@ func (d *Database) Exists() (bool, error) {
 }
 
 func (d *Database) Drop() error {
-	return d.database.Drop(d.ctx)
+	return d.withRetry("drop database", func() error {
+		return d.database.Drop(d.ctx)
+	})
+}
+
+// ListCollectionNames returns the names of every collection in the database.
+func (d *Database) ListCollectionNames() ([]string, error) {
+	var names []string
+	err := d.withRetry("list collections", func() error {
+		var err error
+		names, err = d.database.ListCollectionNames(d.ctx, bson.D{})
+		return err
+	})
+	return names, err
+}
+
+// ListCollectionTypes returns the types of every collection in the database
+// keyed by the names of the collections.
+func (d *Database) ListCollectionTypes() (map[string]string, error) {
+	types := map[string]string{}
+	err := d.withRetry("list collections", func() error {
+		specs, err := d.database.ListCollectionSpecifications(d.ctx, bson.D{})
+		if err != nil {
+			return err
+		}
+		for _, spec := range specs {
+			types[spec.Name] = spec.Type
+		}
+		return nil
+	})
+	return types, err
 }
 
 func (d *Database) IsEmpty() (bool, error) {
-	collections, err := d.database.ListCollectionNames(d.ctx, bson.D{})
+	collections, err := d.ListCollectionNames()
 	if err != nil {
 		return false, err
 	}
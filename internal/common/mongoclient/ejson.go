@@ -0,0 +1,103 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ParseEJSONDocument parses a document written in extended JSON,
+// either in the canonical or the relaxed mode.
+func ParseEJSONDocument(value string) (bson.Raw, error) {
+	var doc bson.D
+	if err := bson.UnmarshalExtJSON([]byte(value), false, &doc); err != nil {
+		return nil, err
+	}
+	return bson.Marshal(doc)
+}
+
+// EJSONString returns the document in relaxed extended JSON.
+func EJSONString(doc bson.Raw) (string, error) {
+	encoded, err := bson.MarshalExtJSON(doc, false, false)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// ParseEJSONArray parses an array written in extended JSON,
+// either in the canonical or the relaxed mode.
+func ParseEJSONArray(value string) (bson.A, error) {
+	var array bson.A
+	if err := bson.UnmarshalExtJSON([]byte(value), false, &array); err != nil {
+		return nil, err
+	}
+	return array, nil
+}
+
+// EJSONArrayString returns the array of documents in relaxed extended JSON.
+func EJSONArrayString(array bson.A) (string, error) {
+	elements := make([]string, 0, len(array))
+	for _, element := range array {
+		encoded, err := bson.MarshalExtJSON(element, false, false)
+		if err != nil {
+			return "", err
+		}
+		elements = append(elements, string(encoded))
+	}
+	return "[" + strings.Join(elements, ",") + "]", nil
+}
+
+// SelectFields returns the fields of the document named in the keys, in the order of the keys.
+// The keys missing in the document are left out.
+func SelectFields(doc bson.Raw, keys []string) (bson.Raw, error) {
+	selected := bson.D{}
+	for _, key := range keys {
+		value, err := doc.LookupErr(key)
+		if err != nil {
+			continue
+		}
+		selected = append(selected, bson.E{Key: key, Value: value})
+	}
+	return bson.Marshal(selected)
+}
+
+// EqualEJSON reports whether the extended JSON documents or arrays are equal
+// regardless of the order of the fields and their formatting.
+// Values which cannot be parsed are never equal.
+func EqualEJSON(a, b string) bool {
+	normalizedA, err := normalizeEJSON(a)
+	if err != nil {
+		return false
+	}
+	normalizedB, err := normalizeEJSON(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(normalizedA, normalizedB)
+}
+
+// normalizeEJSON decodes the value in canonical extended JSON,
+// so that the values of the same BSON types are decoded alike.
+// The value is wrapped in a document, as only documents are encoded at the top level.
+func normalizeEJSON(value string) (interface{}, error) {
+	doc, err := ParseEJSONDocument(`{"value":` + value + `}`)
+	if err != nil {
+		return nil, err
+	}
+	canonical, err := bson.MarshalExtJSON(doc, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(canonical, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized["value"], nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient_test
+
+import (
+	"testing"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+)
+
+type EqualEJSONTestCase struct {
+	name     string
+	a        string
+	b        string
+	expected bool
+}
+
+func TestEqualEJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []EqualEJSONTestCase{
+		{
+			name:     "field-order",
+			a:        `{"$jsonSchema": {"required": ["name"], "bsonType": "object"}}`,
+			b:        `{"$jsonSchema":{"bsonType":"object","required":["name"]}}`,
+			expected: true,
+		},
+		{
+			name:     "canonical-and-relaxed",
+			a:        `{"age": {"$gte": {"$numberInt": "18"}}}`,
+			b:        `{"age": {"$gte": 18}}`,
+			expected: true,
+		},
+		{
+			name:     "array-order",
+			a:        `{"required": ["name", "age"]}`,
+			b:        `{"required": ["age", "name"]}`,
+			expected: false,
+		},
+		{
+			name:     "number-type",
+			a:        `{"age": {"$gte": 18}}`,
+			b:        `{"age": {"$gte": 18.5}}`,
+			expected: false,
+		},
+		{
+			name:     "pipeline",
+			a:        `[{"$match": {"active": true}}, {"$project": {"email": 0, "_id": 1}}]`,
+			b:        `[{"$match":{"active":true}},{"$project":{"_id":1,"email":0}}]`,
+			expected: true,
+		},
+		{
+			name:     "pipeline-order",
+			a:        `[{"$match": {"active": true}}, {"$limit": 10}]`,
+			b:        `[{"$limit": 10}, {"$match": {"active": true}}]`,
+			expected: false,
+		},
+		{
+			name:     "invalid",
+			a:        `{"age":`,
+			b:        `{"age":`,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual := mongoclient.EqualEJSON(testCase.a, testCase.b); actual != testCase.expected {
+				t.Errorf("expected %v, got %v", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestEJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	doc, err := mongoclient.ParseEJSONDocument(`{"_id": {"$oid": "5f1e7b5d8f1e4b3a2c1d0e9f"}, "count": 1}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	encoded, err := mongoclient.EJSONString(doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `{"_id":{"$oid":"5f1e7b5d8f1e4b3a2c1d0e9f"},"count":1}`; encoded != expected {
+		t.Errorf("expected %s, got %s", expected, encoded)
+	}
+
+	if _, err := mongoclient.ParseEJSONDocument(`[1, 2]`); err == nil {
+		t.Error("expected an error for an array, got none")
+	}
+}
+
+func TestEJSONArrayRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	array, err := mongoclient.ParseEJSONArray(`[{"$match": {"createdAt": {"$gte": {"$date": "2024-01-01T00:00:00Z"}}}}, {"$limit": 10}]`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	encoded, err := mongoclient.EJSONArrayString(array)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `[{"$match":{"createdAt":{"$gte":{"$date":"2024-01-01T00:00:00Z"}}}},{"$limit":10}]`; encoded != expected {
+		t.Errorf("expected %s, got %s", expected, encoded)
+	}
+
+	if _, err := mongoclient.ParseEJSONArray(`{"$limit": 10}`); err == nil {
+		t.Error("expected an error for a document, got none")
+	}
+}
+
+func TestSelectFields(t *testing.T) {
+	t.Parallel()
+
+	doc, err := mongoclient.ParseEJSONDocument(`{"capped": true, "size": 4096, "indexOptionDefaults": {"storageEngine": {}}}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	selected, err := mongoclient.SelectFields(doc, []string{"indexOptionDefaults", "missing", "capped"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	encoded, err := mongoclient.EJSONString(selected)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `{"indexOptionDefaults":{"storageEngine":{}},"capped":true}`; encoded != expected {
+		t.Errorf("expected %s, got %s", expected, encoded)
+	}
+}
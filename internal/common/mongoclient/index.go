@@ -6,57 +6,283 @@ package mongoclient
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 )
 
+// Types of the index keys, which are either directions or special index types.
+const (
+	IndexKeyAscending  = "1"
+	IndexKeyDescending = "-1"
+	IndexKeyHashed     = "hashed"
+	IndexKeyText       = "text"
+	IndexKey2DSphere   = "2dsphere"
+	IndexKey2D         = "2d"
+)
+
+// The keys of the text index stored by the server in place of the text fields.
+const (
+	textIndexKey      = "_fts"
+	textIndexTermsKey = "_ftsx"
+)
+
+var IndexKeyTypes = []string{IndexKeyAscending, IndexKeyDescending, IndexKeyHashed, IndexKeyText, IndexKey2DSphere, IndexKey2D}
+
+// IndexKey is a key of an index, which indexes the field by the type.
+type IndexKey struct {
+	Field string
+	Type  string
+}
+
+// value returns the value of the key in the keys document of the index,
+// where the directions are numbers and the special index types are strings.
+func (k IndexKey) value() interface{} {
+	if direction, err := strconv.Atoi(k.Type); err == nil {
+		return int32(direction)
+	}
+	return k.Type
+}
+
+// indexKeyType returns the type of the key from its value in the keys document,
+// where the server may report the directions as any type of number.
+func indexKeyType(value bson.RawValue) (string, error) {
+	switch value.Type {
+	case bsontype.String:
+		return value.StringValue(), nil
+	case bsontype.Int32, bsontype.Int64, bsontype.Double, bsontype.Decimal128:
+		if direction, ok := value.AsInt64OK(); ok {
+			return strconv.FormatInt(direction, 10), nil
+		}
+		if direction, ok := value.DoubleOK(); ok {
+			return strconv.FormatInt(int64(direction), 10), nil
+		}
+	}
+	return "", fmt.Errorf("unexpected index key value %s", value.String())
+}
+
+// IndexKeysDocument returns the keys document of the index, in the order of the keys.
+func IndexKeysDocument(keys []IndexKey) bson.D {
+	document := make(bson.D, 0, len(keys))
+	for _, key := range keys {
+		document = append(document, bson.E{Key: key.Field, Value: key.value()})
+	}
+	return document
+}
+
+// WildcardField is the field of the wildcard key indexing all the fields.
+// The wildcard key of a subdocument is the field of the subdocument followed by ".$**".
+const WildcardField = "$**"
+
+// IsWildcardKey reports whether the key is a wildcard key indexing
+// all the fields or the fields of a subdocument.
+func IsWildcardKey(key IndexKey) bool {
+	return key.Field == WildcardField || strings.HasSuffix(key.Field, "."+WildcardField)
+}
+
+// EqualIndexKeys reports whether the keys are the keys of the same index.
+// The keys are compared in order, except the adjacent text keys whose order
+// is not kept by the server, as the text fields are stored in the weights of the index.
+func EqualIndexKeys(a, b []IndexKey) bool {
+	return slices.Equal(sortTextKeys(a), sortTextKeys(b))
+}
+
+// sortTextKeys returns the keys where the adjacent text keys are sorted by field.
+func sortTextKeys(keys []IndexKey) []IndexKey {
+	sorted := slices.Clone(keys)
+	for start := 0; start < len(sorted); start++ {
+		if sorted[start].Type != IndexKeyText {
+			continue
+		}
+		end := start
+		for end < len(sorted) && sorted[end].Type == IndexKeyText {
+			end++
+		}
+		slices.SortFunc(sorted[start:end], func(a, b IndexKey) int {
+			return strings.Compare(a.Field, b.Field)
+		})
+		start = end
+	}
+	return sorted
+}
+
+// DefaultIndexName returns the name the server gives to an index of the keys.
+func DefaultIndexName(keys []IndexKey) string {
+	parts := make([]string, 0, len(keys)*2)
+	for _, key := range keys {
+		parts = append(parts, key.Field, key.Type)
+	}
+	return strings.Join(parts, "_")
+}
+
+// MaxExpireAfterSeconds is the largest number of seconds the TTL index can expire the documents after.
+const MaxExpireAfterSeconds = 2147483647
+
+// IndexOptions are the options of the index other than its keys.
+type IndexOptions struct {
+	Unique bool
+
+	// ExpireAfterSeconds makes the index a TTL index, which removes the documents
+	// the number of seconds after the date in the indexed field.
+	ExpireAfterSeconds *int32
+
+	// PartialFilterExpression makes the index a partial index,
+	// which indexes only the documents matching the filter.
+	PartialFilterExpression bson.Raw
+
+	// Sparse makes the index skip the documents missing the indexed fields.
+	Sparse bool
+
+	// Hidden hides the index from the query planner.
+	Hidden bool
+
+	// Weights are the weights of the fields of the text index, which are 1 if not set.
+	Weights map[string]int32
+
+	// DefaultLanguage is the language of the text index used unless
+	// the document sets its language in the field named by LanguageOverride.
+	DefaultLanguage  string
+	LanguageOverride string
+
+	// TextIndexVersion is the version of the text index.
+	TextIndexVersion int32
+
+	// SphereIndexVersion is the version of the 2dsphere index.
+	SphereIndexVersion int32
+
+	// Bits, Min and Max are the precision and the bounds of the coordinates
+	// of the 2d index, where Min and Max are unset for the default bounds.
+	Bits int32
+	Min  *float64
+	Max  *float64
+
+	// WildcardProjection selects the fields indexed by the wildcard key on all the fields.
+	WildcardProjection bson.Raw
+}
+
+// weightsDocument returns the weights of the text index in the order of the fields.
+func weightsDocument(weights map[string]int32) bson.D {
+	fields := make([]string, 0, len(weights))
+	for field := range weights {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	document := make(bson.D, 0, len(fields))
+	for _, field := range fields {
+		document = append(document, bson.E{Key: field, Value: weights[field]})
+	}
+	return document
+}
+
+// indexOptions returns the options to create the index with.
+func (o *IndexOptions) indexOptions() *options.IndexOptions {
+	opts := options.Index().SetUnique(o.Unique)
+	if o.ExpireAfterSeconds != nil {
+		opts.SetExpireAfterSeconds(*o.ExpireAfterSeconds)
+	}
+	if len(o.PartialFilterExpression) > 0 {
+		opts.SetPartialFilterExpression(o.PartialFilterExpression)
+	}
+	if o.Sparse {
+		opts.SetSparse(true)
+	}
+	if o.Hidden {
+		opts.SetHidden(true)
+	}
+	if len(o.Weights) > 0 {
+		opts.SetWeights(weightsDocument(o.Weights))
+	}
+	if o.DefaultLanguage != "" {
+		opts.SetDefaultLanguage(o.DefaultLanguage)
+	}
+	if o.LanguageOverride != "" {
+		opts.SetLanguageOverride(o.LanguageOverride)
+	}
+	if o.TextIndexVersion != 0 {
+		opts.SetTextVersion(o.TextIndexVersion)
+	}
+	if o.SphereIndexVersion != 0 {
+		opts.SetSphereVersion(o.SphereIndexVersion)
+	}
+	if o.Bits != 0 {
+		opts.SetBits(o.Bits)
+	}
+	if o.Min != nil {
+		opts.SetMin(*o.Min)
+	}
+	if o.Max != nil {
+		opts.SetMax(*o.Max)
+	}
+	if len(o.WildcardProjection) > 0 {
+		opts.SetWildcardProjection(o.WildcardProjection)
+	}
+	return opts
+}
+
 type SanitizedIndexSpec struct {
-	Name      string
+	Name string
+	Keys []IndexKey
+
+	// Field and Direction are the field and the direction of the first key,
+	// where Direction is zero if the key is not a direction.
 	Field     string
 	Direction int
-	Unique    bool
+
+	IndexOptions
 }
 
 type Index struct {
+	owner      *MongoClient
 	name       string
-	field      string
-	direction  int
-	unique     bool
+	keys       []IndexKey
+	options    IndexOptions
 	client     *mongo.Client
 	database   *mongo.Database
 	collection *mongo.Collection
 	ctx        context.Context
 	logger     *zap.Logger
+	retry      *RetryConfig
 }
 
 func (c *Collection) Index(name string) *Index {
 	return &Index{
+		owner:      c.owner,
 		name:       name,
-		field:      "",
-		direction:  0,
-		unique:     false,
+		keys:       nil,
+		options:    IndexOptions{},
 		client:     c.client,
 		database:   c.database,
 		collection: c.collection,
 		ctx:        c.ctx,
 		logger:     c.logger,
+		retry:      c.retry,
 	}
 }
 
 func (c *Collection) IndexFromField(field string, direction int, unique bool) *Index {
+	return c.IndexFromKeys([]IndexKey{{Field: field, Type: strconv.Itoa(direction)}}, &IndexOptions{Unique: unique})
+}
+
+func (c *Collection) IndexFromKeys(keys []IndexKey, opts *IndexOptions) *Index {
 	return &Index{
+		owner:      c.owner,
 		name:       "",
-		field:      field,
-		direction:  direction,
-		unique:     unique,
+		keys:       keys,
+		options:    *opts,
 		client:     c.client,
 		database:   c.database,
 		collection: c.collection,
 		ctx:        c.ctx,
 		logger:     c.logger,
+		retry:      c.retry,
 	}
 }
 
@@ -64,16 +290,34 @@ func (i *Index) Name() string {
 	return i.name
 }
 
+func (i *Index) Keys() []IndexKey {
+	return i.keys
+}
+
+// Field returns the field of the first key.
 func (i *Index) Field() string {
-	return i.field
+	if len(i.keys) == 0 {
+		return ""
+	}
+	return i.keys[0].Field
 }
 
+// Direction returns the direction of the first key,
+// or zero if the key is not a direction.
 func (i *Index) Direction() int {
-	return i.direction
+	if len(i.keys) == 0 {
+		return 0
+	}
+	direction, _ := strconv.Atoi(i.keys[0].Type)
+	return direction
 }
 
 func (i *Index) Unique() bool {
-	return i.unique
+	return i.options.Unique
+}
+
+func (i *Index) Options() IndexOptions {
+	return i.options
 }
 
 func (i *Index) Client() *MongoClient {
@@ -86,12 +330,14 @@ func (i *Index) Database() *Database {
 
 func (i *Index) Collection() *Collection {
 	return &Collection{
+		owner:      i.owner,
 		name:       i.collection.Name(),
 		client:     i.client,
 		database:   i.database,
 		collection: i.collection,
 		ctx:        i.ctx,
 		logger:     i.logger,
+		retry:      i.retry,
 	}
 }
 
@@ -100,99 +346,234 @@ func (i *Index) WithContext(ctx context.Context) *Index {
 	return i
 }
 
+// withRetry calls the operation with the retries of the index.
+func (i *Index) withRetry(operation string, fn func() error) error {
+	return Retry(i.ctx, i.retry, i.logger, operation, fn)
+}
+
 func (i *Index) GetSpec() (*SanitizedIndexSpec, error) {
+	var spec *SanitizedIndexSpec
+	err := i.withRetry("list indexes", func() error {
+		var err error
+		spec, err = i.getSpec()
+		return err
+	})
+	return spec, err
+}
+
+func (i *Index) getSpec() (*SanitizedIndexSpec, error) {
 	// Check if the index exists
-	specs, err := i.collection.Indexes().ListSpecifications(i.ctx)
+	specs, err := i.listSpecs()
 	if err != nil {
 		return nil, err
 	}
 
-	var spec *SanitizedIndexSpec
-	if i.name == "" {
-		// If the index name is not set, find the index by field,
-		// direction, and unique constraint
-		spec, err = i.findIndexByField(i.field, i.direction, i.unique, specs)
-		if err != nil {
+	if len(i.keys) > 0 {
+		// If the keys are set, find the index by keys and the options
+		// distinguishing the indexes of the same keys, and by name if it is set
+		spec, err := i.findIndexByKeys(i.keys, &i.options, specs)
+		if err != nil || spec == nil {
 			return nil, err
 		}
-		if spec == nil {
+		if i.name != "" && spec.Name != i.name {
 			return nil, nil
 		}
-	} else {
-		// If the index name is set, find the index by name
-		spec, err = i.findIndexByName(i.name, specs)
+		return spec, nil
+	}
+
+	// If the keys are not set, find the index by name
+	return i.findIndexByName(i.name, specs), nil
+}
+
+// indexDocument is an index reported by the listIndexes command, including the options
+// which are left out of the index specifications of the driver.
+type indexDocument struct {
+	Name                    string   `bson:"name"`
+	Key                     bson.Raw `bson:"key"`
+	Unique                  bool     `bson:"unique"`
+	ExpireAfterSeconds      *int32   `bson:"expireAfterSeconds"`
+	PartialFilterExpression bson.Raw `bson:"partialFilterExpression"`
+	Sparse                  bool     `bson:"sparse"`
+	Hidden                  bool     `bson:"hidden"`
+	Weights                 bson.D   `bson:"weights"`
+	DefaultLanguage         string   `bson:"default_language"`
+	LanguageOverride        string   `bson:"language_override"`
+	TextIndexVersion        int32    `bson:"textIndexVersion"`
+	SphereIndexVersion      int32    `bson:"2dsphereIndexVersion"`
+	Bits                    int32    `bson:"bits"`
+	Min                     *float64 `bson:"min"`
+	Max                     *float64 `bson:"max"`
+	WildcardProjection      bson.Raw `bson:"wildcardProjection"`
+}
+
+// listSpecs returns the specifications of the indexes of the collection.
+func (i *Index) listSpecs() ([]*SanitizedIndexSpec, error) {
+	cursor, err := i.collection.Indexes().List(i.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var documents []indexDocument
+	if err := cursor.All(i.ctx, &documents); err != nil {
+		return nil, err
+	}
+
+	specs := make([]*SanitizedIndexSpec, 0, len(documents))
+	for _, document := range documents {
+		spec, err := sanitizeIndexSpec(&document)
 		if err != nil {
 			return nil, err
 		}
-		if spec == nil {
-			return nil, nil
-		}
+		specs = append(specs, spec)
 	}
-	return spec, nil
+	return specs, nil
 }
 
-func (i *Index) findIndexByName(name string, specs []*mongo.IndexSpecification) (*SanitizedIndexSpec, error) {
+func (i *Index) findIndexByName(name string, specs []*SanitizedIndexSpec) *SanitizedIndexSpec {
 	for _, spec := range specs {
 		if spec.Name == name {
-			// Get field name and direction
-			elements, err := spec.KeysDocument.Elements()
-			if err != nil {
-				return nil, err
-			}
-			var field string
-			var direction int
-			for _, element := range elements {
-				field = element.Key()
-				direction = int(element.Value().Int32())
-				break
-			}
+			return spec
+		}
+	}
+	return nil
+}
 
-			// Get unique constraint
-			unique := false
-			if spec.Unique != nil && *spec.Unique {
-				unique = true
-			}
+func (i *Index) findIndexByKeys(keys []IndexKey, opts *IndexOptions, specs []*SanitizedIndexSpec) (*SanitizedIndexSpec, error) {
+	for _, spec := range specs {
+		// The keys are compared in order, as the indexes of the same keys
+		// in another order are different indexes
+		if !EqualIndexKeys(spec.Keys, keys) || spec.Unique != opts.Unique {
+			continue
+		}
 
-			// Return the index spec
-			return &SanitizedIndexSpec{
-				Name:      spec.Name,
-				Field:     field,
-				Direction: direction,
-				Unique:    unique,
-			}, nil
+		// The indexes of the same keys may differ only by their filters
+		// or by the fields of the wildcard key
+		equal, err := equalDocuments(spec.PartialFilterExpression, opts.PartialFilterExpression)
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			continue
+		}
+		equal, err = equalDocuments(spec.WildcardProjection, opts.WildcardProjection)
+		if err != nil {
+			return nil, err
+		}
+		if equal {
+			return spec, nil
 		}
 	}
 	return nil, nil
 }
 
-func (i *Index) findIndexByField(field string, direction int, unique bool, specs []*mongo.IndexSpecification) (*SanitizedIndexSpec, error) {
-	for _, spec := range specs {
-		uniqueMatches := (!unique && spec.Unique == nil) || (spec.Unique != nil && unique == *spec.Unique)
-		if uniqueMatches {
-			elements, err := spec.KeysDocument.Elements()
-			if err != nil {
-				return nil, err
-			}
-			for _, element := range elements {
-				if element.Key() == field && element.Value().Int32() == int32(direction) {
-					return &SanitizedIndexSpec{
-						Name:      spec.Name,
-						Field:     field,
-						Direction: direction,
-						Unique:    unique,
-					}, nil
-				}
+// equalDocuments reports whether the documents are equal regardless of the order
+// of their fields, where a missing document only equals another missing one.
+func equalDocuments(a, b bson.Raw) (bool, error) {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b), nil
+	}
+	encodedA, err := EJSONString(a)
+	if err != nil {
+		return false, err
+	}
+	encodedB, err := EJSONString(b)
+	if err != nil {
+		return false, err
+	}
+	return EqualEJSON(encodedA, encodedB), nil
+}
+
+// sanitizeIndexSpec returns the index specification reported by the server
+// in the form managed by the provider.
+func sanitizeIndexSpec(spec *indexDocument) (*SanitizedIndexSpec, error) {
+	elements, err := spec.Key.Elements()
+	if err != nil {
+		return nil, err
+	}
+	weights, err := textIndexWeights(spec.Weights)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]IndexKey, 0, len(elements))
+	for _, element := range elements {
+		switch element.Key() {
+		case textIndexKey:
+			// The text fields are stored in the weights in place of the text key
+			for _, weight := range spec.Weights {
+				keys = append(keys, IndexKey{Field: weight.Key, Type: IndexKeyText})
 			}
+			continue
+		case textIndexTermsKey:
+			continue
+		}
+
+		keyType, err := indexKeyType(element.Value())
+		if err != nil {
+			return nil, err
 		}
+		keys = append(keys, IndexKey{Field: element.Key(), Type: keyType})
 	}
-	return nil, nil
+
+	sanitized := &SanitizedIndexSpec{
+		Name: spec.Name,
+		Keys: keys,
+		IndexOptions: IndexOptions{
+			Unique:                  spec.Unique,
+			ExpireAfterSeconds:      spec.ExpireAfterSeconds,
+			PartialFilterExpression: spec.PartialFilterExpression,
+			Sparse:                  spec.Sparse,
+			Hidden:                  spec.Hidden,
+			Weights:                 weights,
+			DefaultLanguage:         spec.DefaultLanguage,
+			LanguageOverride:        spec.LanguageOverride,
+			TextIndexVersion:        spec.TextIndexVersion,
+			SphereIndexVersion:      spec.SphereIndexVersion,
+			Bits:                    spec.Bits,
+			Min:                     spec.Min,
+			Max:                     spec.Max,
+			WildcardProjection:      spec.WildcardProjection,
+		},
+	}
+	if len(keys) > 0 {
+		sanitized.Field = keys[0].Field
+		sanitized.Direction, _ = strconv.Atoi(keys[0].Type)
+	}
+	return sanitized, nil
+}
+
+// textIndexWeights returns the weights of the text index,
+// which the server may report as any type of number.
+func textIndexWeights(document bson.D) (map[string]int32, error) {
+	if len(document) == 0 {
+		return nil, nil
+	}
+
+	weights := make(map[string]int32, len(document))
+	for _, weight := range document {
+		switch value := weight.Value.(type) {
+		case int32:
+			weights[weight.Key] = value
+		case int64:
+			weights[weight.Key] = int32(value)
+		case float64:
+			weights[weight.Key] = int32(value)
+		default:
+			return nil, fmt.Errorf("unexpected weight %v of the text index field %s", weight.Value, weight.Key)
+		}
+	}
+	return weights, nil
+}
+
+// WithName names the index to create, which is otherwise named by the server after its keys.
+func (i *Index) WithName(name string) *Index {
+	i.name = name
+	return i
 }
 
 func (i *Index) Hydrate(spec *SanitizedIndexSpec) *Index {
 	i.name = spec.Name
-	i.field = spec.Field
-	i.direction = spec.Direction
-	i.unique = spec.Unique
+	i.keys = spec.Keys
+	i.options = spec.IndexOptions
 	return i
 }
 
@@ -205,24 +586,34 @@ func (i *Index) Exists() (bool, error) {
 }
 
 func (i *Index) EnsureExistance() error {
+	return i.withRetry("create index", i.ensureExistance)
+}
+
+func (i *Index) ensureExistance() error {
 	// Check if the index exists
-	spec, err := i.GetSpec()
+	spec, err := i.getSpec()
 	if err != nil {
 		return err
 	}
 	if spec != nil {
+		// The index may have been created by a previous attempt
+		i.name = spec.Name
 		return nil
 	}
 
 	// Create the index
-	if i.field == "" || i.direction == 0 {
-		return errors.New("unexpected error: field and direction must be set")
+	if len(i.keys) == 0 {
+		return errors.New("unexpected error: keys must be set")
+	}
+	opts := i.options.indexOptions()
+	if i.name != "" {
+		opts.SetName(i.name)
 	}
 	name, err := i.collection.Indexes().CreateOne(
 		i.ctx,
 		mongo.IndexModel{
-			Keys:    bson.D{{Key: i.field, Value: i.direction}},
-			Options: options.Index().SetUnique(i.unique),
+			Keys:    IndexKeysDocument(i.keys),
+			Options: opts,
 		},
 	)
 	if err != nil {
@@ -235,6 +626,75 @@ func (i *Index) EnsureExistance() error {
 }
 
 func (i *Index) Drop() error {
-	_, err := i.collection.Indexes().DropOne(i.ctx, i.name)
-	return err
+	retried := false
+	return i.withRetry("drop index", func() error {
+		_, err := i.collection.Indexes().DropOne(i.ctx, i.name)
+		// A previous attempt may have dropped the index
+		if retried && hasErrorCode(err, errorCodeIndexNotFound) {
+			return nil
+		}
+		retried = true
+		return err
+	})
+}
+
+// SetExpireAfterSeconds changes the number of seconds after which the documents
+// are removed by the TTL index with the collMod command.
+// The index must already be a TTL index.
+func (i *Index) SetExpireAfterSeconds(seconds int32) error {
+	if err := i.modify(bson.E{Key: "expireAfterSeconds", Value: seconds}); err != nil {
+		return err
+	}
+	i.options.ExpireAfterSeconds = &seconds
+	return nil
+}
+
+// SetHidden hides the index from the query planner or unhides it with the collMod command.
+// The hidden index is still maintained, so that it is usable as soon as it is unhidden.
+func (i *Index) SetHidden(hidden bool) error {
+	if err := i.modify(bson.E{Key: "hidden", Value: hidden}); err != nil {
+		return err
+	}
+	i.options.Hidden = hidden
+	return nil
+}
+
+// modify changes the option of the index with the collMod command.
+func (i *Index) modify(option bson.E) error {
+	command := bson.D{
+		{Key: "collMod", Value: i.collection.Name()},
+		{Key: "index", Value: bson.D{
+			{Key: "name", Value: i.name},
+			option,
+		}},
+	}
+
+	return i.withRetry("modify index", func() error {
+		return i.database.RunCommand(i.ctx, command).Err()
+	})
+}
+
+// HasNonDateValues reports whether any document has a value other than a date
+// in the field of the first key, which the TTL index never expires.
+func (i *Index) HasNonDateValues() (bool, error) {
+	field := i.Field()
+	filter := bson.D{{Key: field, Value: bson.D{
+		{Key: "$exists", Value: true},
+		{Key: "$not", Value: bson.D{{Key: "$type", Value: "date"}}},
+	}}}
+
+	var found bool
+	err := i.withRetry("find non-date values", func() error {
+		err := i.collection.FindOne(i.ctx, filter, options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}})).Err()
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			found = false
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		found = true
+		return nil
+	})
+	return found, err
 }
@@ -0,0 +1,109 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient_test
+
+import (
+	"testing"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type IndexKeysTestCase struct {
+	name     string
+	keys     []mongoclient.IndexKey
+	document bson.D
+	index    string
+}
+
+func TestIndexKeys(t *testing.T) {
+	t.Parallel()
+
+	tests := []IndexKeysTestCase{
+		{
+			name:     "single-field",
+			keys:     []mongoclient.IndexKey{{Field: "age", Type: "1"}},
+			document: bson.D{{Key: "age", Value: int32(1)}},
+			index:    "age_1",
+		},
+		{
+			name: "compound",
+			keys: []mongoclient.IndexKey{
+				{Field: "name", Type: "1"},
+				{Field: "age", Type: "-1"},
+			},
+			document: bson.D{{Key: "name", Value: int32(1)}, {Key: "age", Value: int32(-1)}},
+			index:    "name_1_age_-1",
+		},
+		{
+			name: "special-type",
+			keys: []mongoclient.IndexKey{
+				{Field: "tenant", Type: "1"},
+				{Field: "email", Type: "hashed"},
+			},
+			document: bson.D{{Key: "tenant", Value: int32(1)}, {Key: "email", Value: "hashed"}},
+			index:    "tenant_1_email_hashed",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			document := mongoclient.IndexKeysDocument(testCase.keys)
+			expected, _ := bson.Marshal(testCase.document)
+			actual, _ := bson.Marshal(document)
+			if string(expected) != string(actual) {
+				t.Errorf("expected keys document %v, got %v", testCase.document, document)
+			}
+			if name := mongoclient.DefaultIndexName(testCase.keys); name != testCase.index {
+				t.Errorf("expected index name %s, got %s", testCase.index, name)
+			}
+		})
+	}
+}
+
+type EqualIndexKeysTestCase struct {
+	name     string
+	a        []mongoclient.IndexKey
+	b        []mongoclient.IndexKey
+	expected bool
+}
+
+func TestEqualIndexKeys(t *testing.T) {
+	t.Parallel()
+
+	tests := []EqualIndexKeysTestCase{
+		{
+			name:     "same-order",
+			a:        []mongoclient.IndexKey{{Field: "name", Type: "1"}, {Field: "age", Type: "-1"}},
+			b:        []mongoclient.IndexKey{{Field: "name", Type: "1"}, {Field: "age", Type: "-1"}},
+			expected: true,
+		},
+		{
+			name:     "other-order",
+			a:        []mongoclient.IndexKey{{Field: "name", Type: "1"}, {Field: "age", Type: "-1"}},
+			b:        []mongoclient.IndexKey{{Field: "age", Type: "-1"}, {Field: "name", Type: "1"}},
+			expected: false,
+		},
+		{
+			name:     "text-order",
+			a:        []mongoclient.IndexKey{{Field: "tenant", Type: "1"}, {Field: "title", Type: "text"}, {Field: "body", Type: "text"}},
+			b:        []mongoclient.IndexKey{{Field: "tenant", Type: "1"}, {Field: "body", Type: "text"}, {Field: "title", Type: "text"}},
+			expected: true,
+		},
+		{
+			name:     "text-prefix",
+			a:        []mongoclient.IndexKey{{Field: "tenant", Type: "1"}, {Field: "body", Type: "text"}},
+			b:        []mongoclient.IndexKey{{Field: "body", Type: "text"}, {Field: "tenant", Type: "1"}},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual := mongoclient.EqualIndexKeys(testCase.a, testCase.b); actual != testCase.expected {
+				t.Errorf("expected %v, got %v", testCase.expected, actual)
+			}
+		})
+	}
+}
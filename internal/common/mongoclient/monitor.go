@@ -0,0 +1,144 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"context"
+	"strings"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.uber.org/zap"
+)
+
+// redactedCommands are the commands whose whole body is redacted
+// in the logs, since most of their fields are credentials.
+var redactedCommands = []string{
+	"authenticate",
+	"getnonce",
+	"copydb",
+	"copydbgetnonce",
+	"copydbsaslstart",
+}
+
+// redactedFields are the fields of the commands redacted in the logs,
+// keyed by the lowercase names of the commands.
+var redactedFields = map[string][]string{
+	"createuser":   {"pwd"},
+	"updateuser":   {"pwd"},
+	"saslstart":    {"payload"},
+	"saslcontinue": {"payload"},
+	"hello":        {"speculativeAuthenticate"},
+	"ismaster":     {"speculativeAuthenticate"},
+}
+
+// NewCommandMonitor returns a monitor logging the commands sent to the server
+// at the trace level, with their credentials redacted.
+//
+// The events are logged with the logger carried by the context of the operation,
+// or the logger bound to the context if there is none.
+func NewCommandMonitor(logger *zap.Logger) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			// The command is marshaled only if it is going to be logged
+			operationLogger := logging.FromContext(ctx, logger)
+			if !operationLogger.Core().Enabled(logging.TraceLevel) {
+				return
+			}
+			fields := commandFields(e.CommandName, e.DatabaseName, e.RequestID, e.ConnectionID)
+			if command := redactCommand(e.CommandName, e.Command); command != "" {
+				fields = append(fields, zap.String("body", command))
+			}
+			operationLogger.Log(logging.TraceLevel, "command started", fields...)
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			fields := commandFields(e.CommandName, e.DatabaseName, e.RequestID, e.ConnectionID)
+			fields = append(fields, zap.Duration("duration", e.Duration))
+			logging.FromContext(ctx, logger).Log(logging.TraceLevel, "command succeeded", fields...)
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			fields := commandFields(e.CommandName, e.DatabaseName, e.RequestID, e.ConnectionID)
+			fields = append(fields, zap.Duration("duration", e.Duration), zap.String("failure", e.Failure))
+			logging.FromContext(ctx, logger).Log(logging.TraceLevel, "command failed", fields...)
+		},
+	}
+}
+
+// NewPoolMonitor returns a monitor logging the events
+// of the connection pools at the trace level.
+func NewPoolMonitor(logger *zap.Logger) *event.PoolMonitor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &event.PoolMonitor{
+		Event: func(e *event.PoolEvent) {
+			fields := []zap.Field{
+				zap.Namespace("pool"),
+				zap.String("event", e.Type),
+				zap.String("server_address", e.Address),
+			}
+			if e.ConnectionID != 0 {
+				fields = append(fields, zap.Uint64("connection_id", e.ConnectionID))
+			}
+			if e.Duration != 0 {
+				fields = append(fields, zap.Duration("duration", e.Duration))
+			}
+			if e.Reason != "" {
+				fields = append(fields, zap.String("reason", e.Reason))
+			}
+			if e.Error != nil {
+				fields = append(fields, zap.Error(e.Error))
+			}
+			logger.Log(logging.TraceLevel, "connection pool event", fields...)
+		},
+	}
+}
+
+func commandFields(name, database string, requestID int64, connectionID string) []zap.Field {
+	// The connection ID is the address of the server followed by
+	// the number of the connection, e.g. localhost:27017[-3]
+	address, _, _ := strings.Cut(connectionID, "[")
+	return []zap.Field{
+		zap.Namespace("command"),
+		zap.String("name", name),
+		zap.String("database", database),
+		zap.Int64("request_id", requestID),
+		zap.String("server_address", address),
+	}
+}
+
+// redactCommand returns the command as extended JSON with its credentials redacted.
+// It returns an empty string if the command is empty, as the driver empties
+// the sensitive commands by itself.
+func redactCommand(name string, command bson.Raw) string {
+	if len(command) == 0 {
+		return ""
+	}
+
+	name = strings.ToLower(name)
+	for _, redacted := range redactedCommands {
+		if name == redacted {
+			return logging.Mask
+		}
+	}
+
+	var doc bson.D
+	if err := bson.Unmarshal(command, &doc); err != nil {
+		return logging.Mask
+	}
+	for i, elem := range doc {
+		for _, field := range redactedFields[name] {
+			if elem.Key == field {
+				doc[i].Value = logging.Mask
+			}
+		}
+	}
+
+	body, err := bson.MarshalExtJSON(doc, false, false)
+	if err != nil {
+		return logging.Mask
+	}
+	return string(body)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type CommandMonitorTestCase struct {
+	name     string
+	command  bson.D
+	expected []string
+	redacted []string
+}
+
+func TestCommandMonitor(t *testing.T) {
+	t.Parallel()
+
+	tests := []CommandMonitorTestCase{
+		{
+			name:     "find",
+			command:  bson.D{{Key: "find", Value: "users"}, {Key: "filter", Value: bson.D{{Key: "name", Value: "alice"}}}},
+			expected: []string{`"find":"users"`, `"name":"alice"`},
+		},
+		{
+			name:     "createUser",
+			command:  bson.D{{Key: "createUser", Value: "alice"}, {Key: "pwd", Value: "hunter2"}},
+			expected: []string{`"createUser":"alice"`, `"pwd":"***"`},
+			redacted: []string{"hunter2"},
+		},
+		{
+			name:     "saslStart",
+			command:  bson.D{{Key: "saslStart", Value: 1}, {Key: "mechanism", Value: "SCRAM-SHA-256"}, {Key: "payload", Value: []byte("n,,n=alice,r=nonce")}},
+			expected: []string{`"mechanism":"SCRAM-SHA-256"`, `"payload":"***"`},
+			redacted: []string{"nonce"},
+		},
+		{
+			name:     "authenticate",
+			command:  bson.D{{Key: "authenticate", Value: 1}, {Key: "user", Value: "alice"}, {Key: "key", Value: "hunter2"}},
+			redacted: []string{"alice", "hunter2"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			core, logs := observer.New(logging.TraceLevel)
+			monitor := mongoclient.NewCommandMonitor(zap.New(core))
+
+			command, err := bson.Marshal(testCase.command)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			monitor.Started(context.Background(), &event.CommandStartedEvent{
+				Command:      command,
+				CommandName:  testCase.command[0].Key,
+				DatabaseName: "admin",
+				RequestID:    42,
+				ConnectionID: "localhost:27017[-3]",
+			})
+
+			entries := logs.AllUntimed()
+			if len(entries) != 1 {
+				t.Fatalf("expected 1 entry, got %d", len(entries))
+			}
+			if entries[0].Level != logging.TraceLevel {
+				t.Errorf("expected the trace level, got %v", entries[0].Level)
+			}
+
+			fields, _ := entries[0].ContextMap()["command"].(map[string]interface{})
+			if fields["name"] != testCase.command[0].Key || fields["database"] != "admin" ||
+				fields["request_id"] != int64(42) || fields["server_address"] != "localhost:27017" {
+				t.Errorf("unexpected fields: %v", fields)
+			}
+
+			body, _ := fields["body"].(string)
+			for _, expected := range testCase.expected {
+				if !strings.Contains(body, expected) {
+					t.Errorf("expected %s in the body, got %s", expected, body)
+				}
+			}
+			for _, redacted := range testCase.redacted {
+				if strings.Contains(body, redacted) {
+					t.Errorf("expected %s to be redacted, got %s", redacted, body)
+				}
+			}
+		})
+	}
+}
+
+func TestCommandMonitorContextLogger(t *testing.T) {
+	t.Parallel()
+
+	fallback, fallbackLogs := observer.New(logging.TraceLevel)
+	operation, operationLogs := observer.New(logging.TraceLevel)
+	monitor := mongoclient.NewCommandMonitor(zap.New(fallback))
+
+	// The events of an operation are logged with the logger of the operation
+	ctx := logging.NewContext(context.Background(), zap.New(operation).With(zap.String(logging.KeyOperation, "read")))
+	monitor.Failed(ctx, &event.CommandFailedEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{
+			CommandName: "find",
+			Duration:    time.Second,
+		},
+		Failure: "timeout",
+	})
+
+	if fallbackLogs.Len() != 0 {
+		t.Errorf("expected no entry in the fallback logger, got %d", fallbackLogs.Len())
+	}
+	entries := operationLogs.AllUntimed()
+	if len(entries) != 1 || entries[0].Message != "command failed" {
+		t.Fatalf("expected the failure to be logged, got %v", entries)
+	}
+	if entries[0].ContextMap()[logging.KeyOperation] != "read" {
+		t.Errorf("expected the fields of the operation, got %v", entries[0].ContextMap())
+	}
+}
+
+func TestPoolMonitor(t *testing.T) {
+	t.Parallel()
+
+	core, logs := observer.New(logging.TraceLevel)
+	monitor := mongoclient.NewPoolMonitor(zap.New(core))
+	monitor.Event(&event.PoolEvent{
+		Type:         event.ConnectionCreated,
+		Address:      "localhost:27017",
+		ConnectionID: 3,
+	})
+
+	entries := logs.AllUntimed()
+	if len(entries) != 1 || entries[0].Level != logging.TraceLevel {
+		t.Fatalf("expected 1 entry at the trace level, got %v", entries)
+	}
+	fields, _ := entries[0].ContextMap()["pool"].(map[string]interface{})
+	if fields["event"] != event.ConnectionCreated || fields["connection_id"] != uint64(3) {
+		t.Errorf("unexpected fields: %v", fields)
+	}
+}
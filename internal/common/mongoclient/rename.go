@@ -0,0 +1,45 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Rename renames the collection to the name in the database with the renameCollection command,
+// keeping its documents and indexes. The collection is copied into the other database
+// when it is moved across databases. The rename fails if the target collection exists.
+func (c *Collection) Rename(database string, name string) error {
+	source := c.database.Name() + "." + c.name
+	target := database + "." + name
+	command := bson.D{
+		{Key: "renameCollection", Value: source},
+		{Key: "to", Value: target},
+		{Key: "dropTarget", Value: false},
+	}
+
+	retried := false
+	return c.withRetry("rename collection", func() error {
+		// A previous attempt may have renamed the collection, while a missing
+		// collection is reported by the server on the first attempt
+		if retried {
+			exists, err := c.exists()
+			if err != nil {
+				return err
+			}
+			if !exists {
+				names, err := c.client.Database(database).ListCollectionNames(c.ctx, bson.D{{Key: "name", Value: name}})
+				if err != nil {
+					return err
+				}
+				if len(names) > 0 {
+					return nil
+				}
+			}
+		}
+		retried = true
+
+		return c.client.Database(AdminDatabaseName).RunCommand(c.ctx, command).Err()
+	})
+}
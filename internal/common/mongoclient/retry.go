@@ -0,0 +1,171 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// Defaults of the retry configuration.
+const (
+	DefaultMaxRetries       = 3
+	DefaultRetryMaxInterval = 5 * time.Second
+
+	// retryInitialInterval is the backoff before the first retry,
+	// which is doubled for every following retry.
+	retryInitialInterval = 100 * time.Millisecond
+)
+
+// Error labels attached by the server or the driver
+// to errors which are safe to retry.
+const (
+	errorLabelRetryableWrite       = "RetryableWriteError"
+	errorLabelTransientTransaction = "TransientTransactionError"
+)
+
+// retryableErrorCodes are server error codes returned while
+// a replica set is electing a new primary or a node is shutting down.
+var retryableErrorCodes = []int{
+	6,     // HostUnreachable
+	7,     // HostNotFound
+	89,    // NetworkTimeout
+	91,    // ShutdownInProgress
+	189,   // PrimarySteppedDown
+	262,   // ExceededTimeLimit
+	9001,  // SocketException
+	10058, // LegacyNotPrimary
+	10107, // NotWritablePrimary
+	11600, // InterruptedAtShutdown
+	11602, // InterruptedDueToReplStateChange
+	13435, // NotPrimaryNoSecondaryOk
+	13436, // NotPrimaryOrSecondary
+}
+
+// Server error codes of the objects already dropped, which are expected
+// when a retried drop has taken effect on a previous attempt.
+const (
+	errorCodeNamespaceNotFound = 26
+	errorCodeIndexNotFound     = 27
+)
+
+// hasErrorCode reports whether the error is a server error with the code.
+func hasErrorCode(err error, code int) bool {
+	var serverErr mongo.ServerError
+	return errors.As(err, &serverErr) && serverErr.HasErrorCode(code)
+}
+
+// RetryConfig configures the retries of operations failed with transient errors.
+type RetryConfig struct {
+	// MaxRetries is the number of retries after the first attempt.
+	// Zero disables retries.
+	MaxRetries int
+
+	// MaxInterval caps the exponential backoff between attempts.
+	MaxInterval time.Duration
+}
+
+func DefaultRetryConfig() *RetryConfig {
+	return &RetryConfig{
+		MaxRetries:  DefaultMaxRetries,
+		MaxInterval: DefaultRetryMaxInterval,
+	}
+}
+
+// backoff returns the interval to wait before the given retry, starting from 1.
+// A random jitter of up to half of the interval is applied, so that concurrent
+// operations do not hit the server at the same time again.
+func (r *RetryConfig) backoff(retry int) time.Duration {
+	interval := r.MaxInterval
+	if shift := retry - 1; shift < 32 && retryInitialInterval<<shift < interval {
+		interval = retryInitialInterval << shift
+	}
+	half := interval / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1)) //nolint:gosec
+}
+
+// IsRetryable reports whether the error is transient,
+// so that the failed operation may succeed if it is tried again.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// The caller gave up
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	// Connections failed or closed
+	if mongo.IsNetworkError(err) {
+		return true
+	}
+
+	var labeled mongo.LabeledError
+	if errors.As(err, &labeled) &&
+		(labeled.HasErrorLabel(errorLabelRetryableWrite) ||
+			labeled.HasErrorLabel(errorLabelTransientTransaction)) {
+		return true
+	}
+
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) {
+		for _, code := range retryableErrorCodes {
+			if serverErr.HasErrorCode(code) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Retry calls the operation until it succeeds, fails with an error
+// which is not retryable, or runs out of retries.
+//
+// The operation must be idempotent, since it may have taken effect
+// on the server even if it failed with a transient error.
+func Retry(ctx context.Context, config *RetryConfig, logger *zap.Logger, operation string, fn func() error) error {
+	err := fn()
+	if config == nil || config.MaxRetries <= 0 {
+		return err
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	for retry := 1; IsRetryable(err); retry++ {
+		if retry > config.MaxRetries {
+			return fmt.Errorf("%s failed after %d retries: %w", operation, config.MaxRetries, err)
+		}
+
+		backoff := config.backoff(retry)
+		logger.Warn(
+			"retrying operation failed with a transient error",
+			zap.String("operation", operation),
+			zap.Int("retry", retry),
+			zap.Int("max_retries", config.MaxRetries),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+
+		err = fn()
+	}
+
+	return err
+}
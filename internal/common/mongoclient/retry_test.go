@@ -0,0 +1,182 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap/zaptest"
+)
+
+type IsRetryableTestCase struct {
+	name     string
+	err      error
+	expected bool
+}
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+
+	tests := []IsRetryableTestCase{
+		{
+			name:     "nil",
+			err:      nil,
+			expected: false,
+		},
+		{
+			name:     "plain-error",
+			err:      errors.New("something went wrong"),
+			expected: false,
+		},
+		{
+			name:     "network-error",
+			err:      mongo.CommandError{Labels: []string{"NetworkError"}},
+			expected: true,
+		},
+		{
+			name:     "retryable-write-error",
+			err:      mongo.WriteException{Labels: []string{"RetryableWriteError"}},
+			expected: true,
+		},
+		{
+			name:     "transient-transaction-error",
+			err:      mongo.CommandError{Labels: []string{"TransientTransactionError"}},
+			expected: true,
+		},
+		{
+			name:     "not-writable-primary",
+			err:      mongo.CommandError{Code: 10107, Name: "NotWritablePrimary"},
+			expected: true,
+		},
+		{
+			name:     "wrapped-primary-stepped-down",
+			err:      fmt.Errorf("failed to create: %w", mongo.CommandError{Code: 189, Name: "PrimarySteppedDown"}),
+			expected: true,
+		},
+		{
+			name:     "namespace-exists",
+			err:      mongo.CommandError{Code: 48, Name: "NamespaceExists"},
+			expected: false,
+		},
+		{
+			name:     "context-canceled",
+			err:      fmt.Errorf("%w: %w", context.Canceled, mongo.CommandError{Labels: []string{"NetworkError"}}),
+			expected: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual := mongoclient.IsRetryable(testCase.err); actual != testCase.expected {
+				t.Errorf("expected %v, got %v", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+type RetryTestCase struct {
+	name             string
+	config           *mongoclient.RetryConfig
+	errs             []error
+	expectedAttempts int
+	expectErr        bool
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	transient := mongo.CommandError{Code: 10107, Name: "NotWritablePrimary"}
+	permanent := mongo.CommandError{Code: 13, Name: "Unauthorized"}
+	config := &mongoclient.RetryConfig{
+		MaxRetries:  2,
+		MaxInterval: time.Millisecond,
+	}
+
+	tests := []RetryTestCase{
+		{
+			name:             "success",
+			config:           config,
+			errs:             []error{nil},
+			expectedAttempts: 1,
+		},
+		{
+			name:             "success-after-retries",
+			config:           config,
+			errs:             []error{transient, transient, nil},
+			expectedAttempts: 3,
+		},
+		{
+			name:             "out-of-retries",
+			config:           config,
+			errs:             []error{transient, transient, transient, nil},
+			expectedAttempts: 3,
+			expectErr:        true,
+		},
+		{
+			name:             "not-retryable",
+			config:           config,
+			errs:             []error{permanent, nil},
+			expectedAttempts: 1,
+			expectErr:        true,
+		},
+		{
+			name:             "retries-disabled",
+			config:           nil,
+			errs:             []error{transient, nil},
+			expectedAttempts: 1,
+			expectErr:        true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			attempts := 0
+			err := mongoclient.Retry(context.Background(), testCase.config, zaptest.NewLogger(t), "test", func() error {
+				err := testCase.errs[attempts]
+				attempts++
+				return err
+			})
+
+			if attempts != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", testCase.expectedAttempts, attempts)
+			}
+			if testCase.expectErr && err == nil {
+				t.Errorf("expected an error, got none")
+			}
+			if !testCase.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestRetryCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	config := &mongoclient.RetryConfig{
+		MaxRetries:  10,
+		MaxInterval: time.Hour,
+	}
+
+	attempts := 0
+	err := mongoclient.Retry(ctx, config, nil, "test", func() error {
+		attempts++
+		cancel()
+		return mongo.CommandError{Labels: []string{"NetworkError"}}
+	})
+
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error, got %v", err)
+	}
+}
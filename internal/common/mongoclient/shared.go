@@ -0,0 +1,98 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// SharedClient holds a single pooled *mongo.Client that is shared
+// by every resource and data source of a provider instance.
+//
+// The underlying client is connected lazily on the first operation
+// and kept open until Close is called, so that the driver's connection
+// pool can be reused across CRUD operations instead of dialing,
+// pinging and disconnecting for each of them.
+//
+// SharedClient is safe for concurrent use.
+type SharedClient struct {
+	config *Config
+	logger *zap.Logger
+	mu     sync.Mutex
+	client *mongo.Client
+	tunnel *SSHTunnel
+}
+
+func NewShared(config *Config, logger *zap.Logger) *SharedClient {
+	return &SharedClient{
+		config: config,
+		logger: logger,
+	}
+}
+
+func (s *SharedClient) Config() *Config {
+	return s.config
+}
+
+// Acquire returns a MongoClient bound to the given context
+// which uses the shared connection pool.
+//
+// The connection is established on the first call. If it fails,
+// the error is returned and the next call tries to connect again.
+func (s *SharedClient) Acquire(ctx context.Context) (*MongoClient, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	logger := logging.WithContext(s.logger, ctx)
+	if s.client == nil {
+		c := New(ctx, s.config).WithLogger(logger)
+		if err := c.Connect(); err != nil {
+			return nil, err
+		}
+		s.client = c.Client()
+		s.tunnel = c.tunnel
+		logger.Debug("connected to the MongoDB server")
+	}
+
+	client := &MongoClient{
+		config: s.config,
+		client: s.client,
+		logger: logger,
+		ctx:    ctx,
+		retry:  s.config.Retry,
+	}
+	return client.WithConcern(s.config.Concern)
+}
+
+// Run acquires a client bound to the given context and passes it to the callback.
+//
+// Unlike MongoClient.Run, the connection is not closed after the callback returns.
+func (s *SharedClient) Run(ctx context.Context, callback func(client *MongoClient, err error)) {
+	client, err := s.Acquire(ctx)
+	callback(client, err)
+}
+
+// Close disconnects the shared client if it has been connected.
+func (s *SharedClient) Close(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == nil {
+		return nil
+	}
+
+	err := s.client.Disconnect(ctx)
+	if s.tunnel != nil {
+		err = errors.Join(err, s.tunnel.Close())
+	}
+	s.client = nil
+	s.tunnel = nil
+	return err
+}
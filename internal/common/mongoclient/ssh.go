@@ -0,0 +1,234 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// DefaultSSHPort is used if the port of the SSH tunnel is not given.
+const DefaultSSHPort = 22
+
+// SSHTunnelConfig holds the options to reach the server
+// through an SSH bastion (jump) host.
+type SSHTunnelConfig struct {
+	Host string
+	Port int
+	User string
+
+	// PrivateKey is a PEM encoded private key to authenticate with.
+	// It is decrypted with PrivateKeyPassword if it is encrypted.
+	PrivateKey         []byte
+	PrivateKeyPassword string
+
+	// UseAgent authenticates with the keys of the agent
+	// listening on SSH_AUTH_SOCK, in addition to PrivateKey.
+	UseAgent bool
+
+	// KnownHostsFiles are used to verify the host key of the bastion.
+	// Defaults to ~/.ssh/known_hosts.
+	KnownHostsFiles []string
+
+	// InsecureIgnoreHostKey skips the verification of the host key.
+	// This should only be used for testing.
+	InsecureIgnoreHostKey bool
+}
+
+func (s *SSHTunnelConfig) address() string {
+	port := s.Port
+	if port == 0 {
+		port = DefaultSSHPort
+	}
+	return net.JoinHostPort(s.Host, strconv.Itoa(port))
+}
+
+func (s *SSHTunnelConfig) clientConfig() (*ssh.ClientConfig, func(), error) {
+	var methods []ssh.AuthMethod
+	cleanup := func() {}
+
+	// Authenticate with the private key
+	if len(s.PrivateKey) > 0 {
+		var signer ssh.Signer
+		var err error
+		if s.PrivateKeyPassword != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase(s.PrivateKey, []byte(s.PrivateKeyPassword))
+		} else {
+			signer, err = ssh.ParsePrivateKey(s.PrivateKey)
+		}
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to parse the SSH private key: %w", err)
+		}
+		methods = append(methods, ssh.PublicKeys(signer))
+	}
+
+	// Authenticate with the keys of the SSH agent
+	if s.UseAgent {
+		socket := os.Getenv("SSH_AUTH_SOCK")
+		if socket == "" {
+			return nil, cleanup, errors.New("SSH agent is requested but SSH_AUTH_SOCK is not set")
+		}
+		conn, err := net.Dial("unix", socket)
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to connect to the SSH agent: %w", err)
+		}
+		cleanup = func() { _ = conn.Close() }
+		methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+	}
+
+	if len(methods) == 0 {
+		return nil, cleanup, errors.New("either a private key or the SSH agent is required to open the SSH tunnel")
+	}
+
+	hostKeyCallback, err := s.hostKeyCallback()
+	if err != nil {
+		cleanup()
+		return nil, func() {}, err
+	}
+
+	return &ssh.ClientConfig{
+		User:            s.User,
+		Auth:            methods,
+		HostKeyCallback: hostKeyCallback,
+	}, cleanup, nil
+}
+
+func (s *SSHTunnelConfig) hostKeyCallback() (ssh.HostKeyCallback, error) {
+	if s.InsecureIgnoreHostKey {
+		return ssh.InsecureIgnoreHostKey(), nil //nolint:gosec
+	}
+
+	files := s.KnownHostsFiles
+	if len(files) == 0 {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the default known_hosts file: %w", err)
+		}
+		files = []string{filepath.Join(home, ".ssh", "known_hosts")}
+	}
+
+	callback, err := knownhosts.New(files...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the known_hosts files: %w", err)
+	}
+	return callback, nil
+}
+
+// SSHTunnel dials connections to the server through an SSH connection
+// to the bastion host. It implements options.ContextDialer, so every
+// host of the connection string (e.g. each member of a replica set)
+// is dialed from the bastion host over the same SSH connection.
+//
+// The SSH connection is established on the first dial
+// and re-established if it is lost. SSHTunnel is safe for concurrent use.
+type SSHTunnel struct {
+	config  *SSHTunnelConfig
+	forward options.ContextDialer
+	mu      sync.Mutex
+	client  *ssh.Client
+	closer  func()
+}
+
+// NewSSHTunnel returns a tunnel which connects to the bastion host
+// with the forward dialer, or a net.Dialer if it is nil.
+func NewSSHTunnel(config *SSHTunnelConfig, forward options.ContextDialer) *SSHTunnel {
+	if forward == nil {
+		forward = &net.Dialer{}
+	}
+	return &SSHTunnel{
+		config:  config,
+		forward: forward,
+	}
+}
+
+// DialContext opens a connection to the address from the bastion host.
+func (t *SSHTunnel) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	client, err := t.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := client.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s through the SSH tunnel: %w", address, err)
+	}
+	return conn, nil
+}
+
+func (t *SSHTunnel) connect(ctx context.Context) (*ssh.Client, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.client != nil {
+		return t.client, nil
+	}
+
+	config, cleanup, err := t.config.clientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	address := t.config.address()
+	conn, err := t.forward.DialContext(ctx, "tcp", address)
+	if err != nil {
+		cleanup()
+		return nil, fmt.Errorf("failed to connect to the SSH host %s: %w", address, err)
+	}
+
+	// Abort the handshake if the context is done before it completes
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	sshConn, channels, requests, err := ssh.NewClientConn(conn, address, config)
+	stopped := stop()
+	if err != nil {
+		_ = conn.Close()
+		cleanup()
+		if !stopped {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("failed to establish the SSH connection to %s: %w", address, err)
+	}
+
+	client := ssh.NewClient(sshConn, channels, requests)
+	t.client = client
+	t.closer = cleanup
+
+	// Forget the connection once it is lost, so that the next dial reconnects
+	go func() {
+		_ = client.Wait()
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if t.client == client {
+			t.client = nil
+			t.closer()
+		}
+	}()
+
+	return client, nil
+}
+
+// Close closes the SSH connection, if any.
+func (t *SSHTunnel) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.client == nil {
+		return nil
+	}
+
+	err := t.client.Close()
+	t.client = nil
+	t.closer()
+	return err
+}
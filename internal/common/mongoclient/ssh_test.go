@@ -0,0 +1,180 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/sshserver"
+)
+
+type SSHTunnelTestCase struct {
+	name      string
+	config    func(server *sshserver.Server) *mongoclient.SSHTunnelConfig
+	expectErr bool
+}
+
+func TestSSHTunnelDialContext(t *testing.T) {
+	t.Parallel()
+
+	server, err := sshserver.Start(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to start the SSH server: %v", err)
+	}
+	t.Cleanup(func() { server.Close() })
+
+	target := echoServer(t)
+
+	tests := []SSHTunnelTestCase{
+		{
+			name: "private-key",
+			config: func(server *sshserver.Server) *mongoclient.SSHTunnelConfig {
+				return &mongoclient.SSHTunnelConfig{
+					Host:            server.Host,
+					Port:            server.Port,
+					User:            sshserver.User,
+					PrivateKey:      readFile(t, server.ClientKeyFile),
+					KnownHostsFiles: []string{server.KnownHostsFile},
+				}
+			},
+		},
+		{
+			name: "encrypted-private-key",
+			config: func(server *sshserver.Server) *mongoclient.SSHTunnelConfig {
+				return &mongoclient.SSHTunnelConfig{
+					Host:               server.Host,
+					Port:               server.Port,
+					User:               sshserver.User,
+					PrivateKey:         readFile(t, server.EncryptedClientKeyFile),
+					PrivateKeyPassword: sshserver.ClientKeyPassword,
+					KnownHostsFiles:    []string{server.KnownHostsFile},
+				}
+			},
+		},
+		{
+			name: "unknown-user",
+			config: func(server *sshserver.Server) *mongoclient.SSHTunnelConfig {
+				return &mongoclient.SSHTunnelConfig{
+					Host:            server.Host,
+					Port:            server.Port,
+					User:            "unknown",
+					PrivateKey:      readFile(t, server.ClientKeyFile),
+					KnownHostsFiles: []string{server.KnownHostsFile},
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "unknown-host-key",
+			config: func(server *sshserver.Server) *mongoclient.SSHTunnelConfig {
+				return &mongoclient.SSHTunnelConfig{
+					Host:            server.Host,
+					Port:            server.Port,
+					User:            sshserver.User,
+					PrivateKey:      readFile(t, server.ClientKeyFile),
+					KnownHostsFiles: []string{emptyFile(t)},
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "insecure-ignore-host-key",
+			config: func(server *sshserver.Server) *mongoclient.SSHTunnelConfig {
+				return &mongoclient.SSHTunnelConfig{
+					Host:                  server.Host,
+					Port:                  server.Port,
+					User:                  sshserver.User,
+					PrivateKey:            readFile(t, server.ClientKeyFile),
+					InsecureIgnoreHostKey: true,
+				}
+			},
+		},
+		{
+			name: "no-auth-method",
+			config: func(server *sshserver.Server) *mongoclient.SSHTunnelConfig {
+				return &mongoclient.SSHTunnelConfig{
+					Host:            server.Host,
+					Port:            server.Port,
+					User:            sshserver.User,
+					KnownHostsFiles: []string{server.KnownHostsFile},
+				}
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			tunnel := mongoclient.NewSSHTunnel(testCase.config(server), nil)
+			defer tunnel.Close()
+
+			conn, err := tunnel.DialContext(context.Background(), "tcp", target)
+			if testCase.expectErr {
+				if err == nil {
+					conn.Close()
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer conn.Close()
+
+			message := []byte(testCase.name)
+			if _, err := conn.Write(message); err != nil {
+				t.Fatalf("failed to write: %v", err)
+			}
+			received := make([]byte, len(message))
+			if _, err := io.ReadFull(conn, received); err != nil {
+				t.Fatalf("failed to read: %v", err)
+			}
+			if string(received) != string(message) {
+				t.Errorf("expected %q, got %q", message, received)
+			}
+			if !slices.Contains(server.Forwarded(), target) {
+				t.Errorf("expected %s to be dialed through the SSH server", target)
+			}
+		})
+	}
+}
+
+// echoServer starts a TCP server which writes back whatever it receives.
+func echoServer(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func emptyFile(t *testing.T) string {
+	name := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(name, nil, 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	return name
+}
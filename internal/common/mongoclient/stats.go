@@ -0,0 +1,104 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CollectionStats are the storage statistics of a collection reported by $collStats.
+// The statistics of the shards are summed up for sharded collections.
+type CollectionStats struct {
+	Count             int64
+	AverageObjectSize int64
+	DataSize          int64
+	StorageSize       int64
+	TotalIndexSize    int64
+	IndexSizes        map[string]int64
+	Capped            bool
+	Sharded           bool
+}
+
+// storageStats is the storageStats document of a $collStats result,
+// whose sizes are reported in bytes.
+type storageStats struct {
+	Count          int64            `bson:"count"`
+	Size           int64            `bson:"size"`
+	StorageSize    int64            `bson:"storageSize"`
+	TotalIndexSize int64            `bson:"totalIndexSize"`
+	IndexSizes     map[string]int64 `bson:"indexSizes"`
+	Capped         bool             `bson:"capped"`
+}
+
+type collStatsResult struct {
+	Shard        string       `bson:"shard,omitempty"`
+	StorageStats storageStats `bson:"storageStats"`
+}
+
+// Stats returns the storage statistics of the collection with the $collStats stage.
+func (c *Collection) Stats() (*CollectionStats, error) {
+	pipeline := bson.A{
+		bson.D{{Key: "$collStats", Value: bson.D{{Key: "storageStats", Value: bson.D{}}}}},
+	}
+
+	var results []collStatsResult
+	err := c.withRetry("collect statistics", func() error {
+		cursor, err := c.collection.Aggregate(c.ctx, pipeline)
+		if err != nil {
+			return err
+		}
+		results = nil
+		return cursor.All(c.ctx, &results)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &CollectionStats{IndexSizes: map[string]int64{}}
+	for _, result := range results {
+		storage := result.StorageStats
+		stats.Count += storage.Count
+		stats.DataSize += storage.Size
+		stats.StorageSize += storage.StorageSize
+		stats.TotalIndexSize += storage.TotalIndexSize
+		for name, size := range storage.IndexSizes {
+			stats.IndexSizes[name] += size
+		}
+		stats.Capped = stats.Capped || storage.Capped
+	}
+
+	// The average is computed from the sums, which tell the average over the shards
+	if stats.Count > 0 {
+		stats.AverageObjectSize = stats.DataSize / stats.Count
+	}
+
+	// Only mongos reports the shards, which hold the unsharded collections as well
+	if len(results) > 0 && results[0].Shard != "" {
+		sharded, err := c.isSharded()
+		if err != nil {
+			return nil, err
+		}
+		stats.Sharded = sharded
+	}
+
+	return stats, nil
+}
+
+// isSharded reports whether the collection is sharded,
+// as recorded in the config database of the sharded cluster.
+func (c *Collection) isSharded() (bool, error) {
+	namespace := c.database.Name() + "." + c.name
+	filter := bson.D{
+		{Key: "_id", Value: namespace},
+		{Key: "dropped", Value: bson.D{{Key: "$ne", Value: true}}},
+	}
+
+	var count int64
+	err := c.withRetry("find sharded collection", func() error {
+		var err error
+		count, err = c.client.Database(ConfigDatabaseName).Collection("collections").CountDocuments(c.ctx, filter)
+		return err
+	})
+	return count > 0, err
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"strings"
+
+	"github.com/youmark/pkcs8"
+)
+
+// TLSConfig holds PEM encoded certificates and keys
+// used to establish TLS connections to the server.
+type TLSConfig struct {
+	// CA is a bundle of certificate authorities to verify the server with.
+	// The system pool is used if it is empty.
+	CA []byte
+
+	// ClientCertificate is the certificate presented to the server.
+	// It may also contain the private key, in which case ClientKey can be left empty.
+	ClientCertificate []byte
+
+	ClientKey         []byte
+	ClientKeyPassword string
+
+	InsecureSkipVerify bool
+	ServerName         string
+}
+
+func (t *TLSConfig) build() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+		ServerName:         t.ServerName,
+	}
+
+	// Load certificate authorities
+	if len(t.CA) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(t.CA) {
+			return nil, errors.New("failed to find any certificate in the CA bundle")
+		}
+		config.RootCAs = pool
+	}
+
+	// Load the client certificate
+	if len(t.ClientCertificate) > 0 {
+		keyPEM := t.ClientKey
+		if len(keyPEM) == 0 {
+			keyPEM = t.ClientCertificate
+		}
+		keyPEM, err := decryptPrivateKey(keyPEM, t.ClientKeyPassword)
+		if err != nil {
+			return nil, err
+		}
+		certificate, err := tls.X509KeyPair(t.ClientCertificate, keyPEM)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	} else if len(t.ClientKey) > 0 {
+		return nil, errors.New("client key is given without a client certificate")
+	}
+
+	return config, nil
+}
+
+// decryptPrivateKey returns the first private key found in the PEM data,
+// decrypted with the password if it is encrypted.
+func decryptPrivateKey(data []byte, password string) ([]byte, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("failed to find any private key in the client key")
+		}
+		if !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			continue
+		}
+
+		isLegacyEncrypted := x509.IsEncryptedPEMBlock(block) //nolint:staticcheck
+		isPKCS8Encrypted := block.Type == "ENCRYPTED PRIVATE KEY"
+		if !isLegacyEncrypted && !isPKCS8Encrypted {
+			return pem.EncodeToMemory(block), nil
+		}
+		if password == "" {
+			return nil, errors.New("client key is encrypted but no password is given")
+		}
+
+		// Encrypted PEM with a DEK-Info header. Note that this format
+		// is insecure by design, but is still produced by some tools.
+		if isLegacyEncrypted {
+			decrypted, err := x509.DecryptPEMBlock(block, []byte(password)) //nolint:staticcheck
+			if err != nil {
+				return nil, err
+			}
+			return pem.EncodeToMemory(&pem.Block{Type: block.Type, Bytes: decrypted}), nil
+		}
+
+		// Encrypted PKCS #8
+		key, err := pkcs8.ParsePKCS8PrivateKey(block.Bytes, []byte(password))
+		if err != nil {
+			return nil, err
+		}
+		decrypted, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: decrypted}), nil
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/tlscert"
+)
+
+type TLSConfigTestCase struct {
+	name      string
+	keyFile   string
+	password  string
+	expectErr bool
+}
+
+func TestTLSConfigClientKey(t *testing.T) {
+	t.Parallel()
+
+	certificates, err := tlscert.Generate(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to generate certificates: %v", err)
+	}
+
+	tests := []TLSConfigTestCase{
+		{
+			name:    "plain-key",
+			keyFile: certificates.ClientKeyFile,
+		},
+		{
+			name:     "encrypted-key",
+			keyFile:  certificates.EncryptedClientKeyFile,
+			password: tlscert.ClientKeyPassword,
+		},
+		{
+			name:      "encrypted-key-without-password",
+			keyFile:   certificates.EncryptedClientKeyFile,
+			expectErr: true,
+		},
+		{
+			name:      "encrypted-key-with-wrong-password",
+			keyFile:   certificates.EncryptedClientKeyFile,
+			password:  "wrong-password",
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := &mongoclient.Config{
+				URI: "mongodb://localhost:27017",
+				TLS: &mongoclient.TLSConfig{
+					CA:                readFile(t, certificates.CAFile),
+					ClientCertificate: readFile(t, certificates.ClientCertificateFile),
+					ClientKey:         readFile(t, testCase.keyFile),
+					ClientKeyPassword: testCase.password,
+				},
+			}
+
+			opts, err := config.ClientOptions()
+			if testCase.expectErr {
+				if err == nil {
+					t.Errorf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if opts.TLSConfig == nil || len(opts.TLSConfig.Certificates) != 1 {
+				t.Errorf("expected the client certificate to be loaded")
+			}
+		})
+	}
+}
+
+func readFile(t *testing.T, name string) []byte {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", name, err)
+	}
+	return content
+}
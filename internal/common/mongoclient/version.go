@@ -0,0 +1,61 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ServerVersion is the version of the MongoDB server.
+type ServerVersion struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseServerVersion parses a version such as 7.0.2 or 6.0.0-rc1.
+func ParseServerVersion(version string) (*ServerVersion, error) {
+	release, _, _ := strings.Cut(version, "-")
+	parts := strings.Split(release, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid server version: %s", version)
+	}
+
+	numbers := make([]int, 3)
+	for i := 0; i < len(parts) && i < len(numbers); i++ {
+		number, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid server version: %s", version)
+		}
+		numbers[i] = number
+	}
+	return &ServerVersion{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// AtLeast reports whether the version is the given version or later.
+func (v *ServerVersion) AtLeast(major, minor int) bool {
+	return v.Major > major || (v.Major == major && v.Minor >= minor)
+}
+
+func (v *ServerVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// ServerVersion returns the version of the server with the buildInfo command.
+func (c *MongoClient) ServerVersion() (*ServerVersion, error) {
+	var info struct {
+		Version string `bson:"version"`
+	}
+	err := Retry(c.ctx, c.retry, c.logger, "build info", func() error {
+		return c.client.Database(AdminDatabaseName).RunCommand(c.ctx, bson.D{{Key: "buildInfo", Value: 1}}).Decode(&info)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ParseServerVersion(info.Version)
+}
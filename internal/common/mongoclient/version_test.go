@@ -0,0 +1,51 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient_test
+
+import (
+	"testing"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+)
+
+type ParseServerVersionTestCase struct {
+	name      string
+	version   string
+	expected  string
+	atLeast60 bool
+	expectErr bool
+}
+
+func TestParseServerVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []ParseServerVersionTestCase{
+		{name: "release", version: "7.0.2", expected: "7.0.2", atLeast60: true},
+		{name: "release-candidate", version: "6.0.0-rc1", expected: "6.0.0", atLeast60: true},
+		{name: "older", version: "5.3.1", expected: "5.3.1"},
+		{name: "major-and-minor", version: "4.4", expected: "4.4.0"},
+		{name: "invalid", version: "latest", expectErr: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			version, err := mongoclient.ParseServerVersion(testCase.version)
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if version.String() != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, version)
+			}
+			if version.AtLeast(6, 0) != testCase.atLeast60 {
+				t.Errorf("expected AtLeast(6, 0) to be %v", testCase.atLeast60)
+			}
+		})
+	}
+}
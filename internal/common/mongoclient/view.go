@@ -0,0 +1,59 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package mongoclient
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ViewOptions are the options of a read-only view.
+type ViewOptions struct {
+	// ViewOn is the name of the collection or the view the view is defined on.
+	ViewOn    string
+	Pipeline  bson.A
+	Collation *Collation
+}
+
+// CreateView creates the view if no collection or view of the name exists.
+func (d *Database) CreateView(name string, opts *ViewOptions) error {
+	return d.withRetry("create view", func() error {
+		// A previous attempt may have created the view
+		exists, err := d.Collection(name).exists()
+		if err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+
+		viewOpts := options.CreateView()
+		if opts.Collation != nil {
+			viewOpts.SetCollation(opts.Collation.options())
+		}
+		pipeline := opts.Pipeline
+		if pipeline == nil {
+			pipeline = bson.A{}
+		}
+		return d.database.CreateView(d.ctx, name, opts.ViewOn, pipeline, viewOpts)
+	})
+}
+
+// ModifyView changes the source and the pipeline of the view with the collMod command.
+// The collation of a view cannot be changed.
+func (c *Collection) ModifyView(opts *ViewOptions) error {
+	pipeline := opts.Pipeline
+	if pipeline == nil {
+		pipeline = bson.A{}
+	}
+	command := bson.D{
+		{Key: "collMod", Value: c.name},
+		{Key: "viewOn", Value: opts.ViewOn},
+		{Key: "pipeline", Value: pipeline},
+	}
+
+	return c.withRetry("modify view", func() error {
+		return c.database.RunCommand(c.ctx, command).Err()
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcecollation
+
+import (
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Model describes the collation block.
+type Model struct {
+	Locale          types.String `tfsdk:"locale"`
+	CaseLevel       types.Bool   `tfsdk:"case_level"`
+	CaseFirst       types.String `tfsdk:"case_first"`
+	Strength        types.Int64  `tfsdk:"strength"`
+	NumericOrdering types.Bool   `tfsdk:"numeric_ordering"`
+	Alternate       types.String `tfsdk:"alternate"`
+	MaxVariable     types.String `tfsdk:"max_variable"`
+	Normalization   types.Bool   `tfsdk:"normalization"`
+	Backwards       types.Bool   `tfsdk:"backwards"`
+}
+
+// ToCollation returns the collation of the block, or nil if the block is not set.
+// The server fills in the options which are not set with the defaults of the locale.
+func ToCollation(data *Model) *mongoclient.Collation {
+	if data == nil {
+		return nil
+	}
+
+	return &mongoclient.Collation{
+		Locale:          data.Locale.ValueString(),
+		CaseLevel:       data.CaseLevel.ValueBool(),
+		CaseFirst:       data.CaseFirst.ValueString(),
+		Strength:        int(data.Strength.ValueInt64()),
+		NumericOrdering: data.NumericOrdering.ValueBool(),
+		Alternate:       data.Alternate.ValueString(),
+		MaxVariable:     data.MaxVariable.ValueString(),
+		Normalization:   data.Normalization.ValueBool(),
+		Backwards:       data.Backwards.ValueBool(),
+	}
+}
+
+// FromCollation returns the block of the collation reported by the server,
+// or nil if there is no collation.
+func FromCollation(collation *mongoclient.Collation) *Model {
+	if collation == nil {
+		return nil
+	}
+
+	return &Model{
+		Locale:          basetypes.NewStringValue(collation.Locale),
+		CaseLevel:       basetypes.NewBoolValue(collation.CaseLevel),
+		CaseFirst:       stringValue(collation.CaseFirst),
+		Strength:        basetypes.NewInt64Value(int64(collation.Strength)),
+		NumericOrdering: basetypes.NewBoolValue(collation.NumericOrdering),
+		Alternate:       stringValue(collation.Alternate),
+		MaxVariable:     stringValue(collation.MaxVariable),
+		Normalization:   basetypes.NewBoolValue(collation.Normalization),
+		Backwards:       basetypes.NewBoolValue(collation.Backwards),
+	}
+}
+
+func stringValue(value string) basetypes.StringValue {
+	if value == "" {
+		return basetypes.NewStringNull()
+	}
+	return basetypes.NewStringValue(value)
+}
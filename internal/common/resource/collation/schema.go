@@ -0,0 +1,82 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcecollation
+
+import (
+	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// ResourceBlock returns the collation block of a resource whose collation
+// cannot be changed, so that changing the block replaces the resource.
+// The options which are not configured are reported with the defaults of the locale.
+func ResourceBlock(description string) schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"locale": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						[ICU locale](https://www.mongodb.com/docs/manual/reference/collation-locales-defaults/#std-label-collation-languages-locales),
+						such as %s or %s.
+					`,
+					mdutils.InlineCodeBlock("en"),
+					mdutils.InlineCodeBlock("fr_CA"),
+				),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"case_level":       boolAttribute("Whether to compare the case at the primary and secondary strength levels."),
+			"case_first":       stringAttribute("Sort order of the case differences, which is one of `upper`, `lower` and `off`."),
+			"strength":         int64Attribute("Level of the comparison, from `1` comparing the base characters only to `5` comparing every difference."),
+			"numeric_ordering": boolAttribute("Whether to compare the numeric strings as numbers."),
+			"alternate":        stringAttribute("Whether to consider the whitespace and the punctuation as base characters, which is one of `non-ignorable` and `shifted`."),
+			"max_variable":     stringAttribute("Characters ignored with the `shifted` alternate, which is one of `punct` and `space`."),
+			"normalization":    boolAttribute("Whether to check the text requires normalization."),
+			"backwards":        boolAttribute("Whether to sort the strings with diacritics from the back of the string."),
+		},
+	}
+}
+
+func stringAttribute(description string) schema.Attribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+		},
+	}
+}
+
+func boolAttribute(description string) schema.Attribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+			boolplanmodifier.RequiresReplaceIfConfigured(),
+		},
+	}
+}
+
+func int64Attribute(description string) schema.Attribute {
+	return schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+			int64planmodifier.RequiresReplaceIfConfigured(),
+		},
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceconcern
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MinMaxStaleness is the smallest max staleness accepted by the server.
+const MinMaxStaleness = 90 * time.Second
+
+// ReadPreferenceModel describes the read_preference block.
+type ReadPreferenceModel struct {
+	Mode         types.String `tfsdk:"mode"`
+	TagSets      types.List   `tfsdk:"tag_sets"`
+	MaxStaleness types.String `tfsdk:"max_staleness"`
+}
+
+// WriteConcernModel describes the write_concern block.
+type WriteConcernModel struct {
+	W       types.String `tfsdk:"w"`
+	Journal types.Bool   `tfsdk:"journal"`
+}
+
+// ToConfig converts the read_preference block, the read_concern attribute
+// and the write_concern block to the configuration of the client.
+// Any of them may be unset, and nil is returned if all of them are.
+func ToConfig(
+	ctx context.Context,
+	readPreference *ReadPreferenceModel,
+	readConcern types.String,
+	writeConcern *WriteConcernModel,
+	diags *diag.Diagnostics,
+) *mongoclient.ConcernConfig {
+	if readPreference == nil && readConcern.ValueString() == "" && writeConcern == nil {
+		return nil
+	}
+
+	config := &mongoclient.ConcernConfig{}
+
+	if readPreference != nil {
+		config.ReadPreference = readPreferenceConfig(ctx, readPreference, diags)
+	}
+
+	if level := readConcern.ValueString(); level != "" {
+		if !slices.Contains(mongoclient.ReadConcernLevels, level) {
+			addError(path.Root("read_concern"), readConcernDescription, diags)
+		}
+		config.ReadConcern = level
+	}
+
+	if writeConcern != nil {
+		config.WriteConcern = writeConcernConfig(writeConcern, diags)
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+	return config
+}
+
+func readPreferenceConfig(ctx context.Context, data *ReadPreferenceModel, diags *diag.Diagnostics) *mongoclient.ReadPreferenceConfig {
+	root := path.Root("read_preference")
+
+	mode := data.Mode.ValueString()
+	if !slices.Contains(mongoclient.ReadPreferenceModes, mode) {
+		addError(root.AtName("mode"), readPreferenceModeDescription, diags)
+		return nil
+	}
+	config := &mongoclient.ReadPreferenceConfig{
+		Mode: mode,
+	}
+
+	if !data.TagSets.IsNull() {
+		diags.Append(data.TagSets.ElementsAs(ctx, &config.TagSets, false)...)
+		if len(config.TagSets) > 0 && mode == mongoclient.ReadPreferencePrimary {
+			addError(root.AtName("tag_sets"), "tag_sets must not be set with the primary mode", diags)
+		}
+	}
+
+	if value := data.MaxStaleness.ValueString(); value != "" {
+		maxStaleness, err := time.ParseDuration(value)
+		switch {
+		case err != nil || maxStaleness < MinMaxStaleness:
+			addError(root.AtName("max_staleness"), maxStalenessDescription, diags)
+		case mode == mongoclient.ReadPreferencePrimary:
+			addError(root.AtName("max_staleness"), "max_staleness must not be set with the primary mode", diags)
+		}
+		config.MaxStaleness = maxStaleness
+	}
+
+	return config
+}
+
+func writeConcernConfig(data *WriteConcernModel, diags *diag.Diagnostics) *mongoclient.WriteConcernConfig {
+	root := path.Root("write_concern")
+
+	w := data.W.ValueString()
+	if n, err := strconv.Atoi(w); err == nil && n < 0 {
+		addError(root.AtName("w"), writeConcernWDescription, diags)
+	}
+
+	config := &mongoclient.WriteConcernConfig{
+		W: w,
+	}
+	if !data.Journal.IsNull() {
+		journal := data.Journal.ValueBool()
+		config.Journal = &journal
+	}
+	return config
+}
+
+func addError(attribute path.Path, description string, diags *diag.Diagnostics) {
+	diags.Append(
+		diag.WithPath(
+			attribute,
+			errs.NewInvalidInputValue(description).ToDiagnostic(),
+		),
+	)
+}
+
+// Descriptions shared by the validators and the conversion.
+var (
+	readPreferenceModeDescription = fmt.Sprintf(
+		"mode must be one of %s",
+		strings.Join(mongoclient.ReadPreferenceModes, ", "),
+	)
+	readConcernDescription = fmt.Sprintf(
+		"read_concern must be one of %s",
+		strings.Join(mongoclient.ReadConcernLevels, ", "),
+	)
+	maxStalenessDescription = fmt.Sprintf(
+		`max_staleness must be a duration of at least %s such as "90s" or "2m"`,
+		MinMaxStaleness,
+	)
+	writeConcernWDescription = fmt.Sprintf(
+		`w must be a non-negative number, %q or the name of a custom write concern`,
+		mongoclient.WriteConcernMajority,
+	)
+)
@@ -0,0 +1,170 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceconcern
+
+import (
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Descriptions of the attributes, shared by the provider,
+// the resources and the data sources.
+var (
+	ReadPreferenceModeDescription = mdutils.FormatSchemaDescription(
+		`
+			Read preference mode, which is one of %s.
+		`,
+		mdutils.InlineCodeBlocks(mongoclient.ReadPreferenceModes),
+	)
+	TagSetsDescription = mdutils.FormatSchemaDescription(
+		`
+			Tag sets to select the members to read from. The sets are tried in order
+			until a member matching every tag of a set is found,
+			and an empty set matches any member.
+			Must not be set with the %s mode.
+		`,
+		mdutils.InlineCodeBlock(mongoclient.ReadPreferencePrimary),
+	)
+	MaxStalenessDescription = mdutils.FormatSchemaDescription(
+		`
+			Maximum replication lag of the secondaries to read from,
+			as a duration of at least %s such as %s.
+			Must not be set with the %s mode.
+		`,
+		mdutils.InlineCodeBlock(MinMaxStaleness.String()),
+		mdutils.InlineCodeBlock("2m"),
+		mdutils.InlineCodeBlock(mongoclient.ReadPreferencePrimary),
+	)
+	WriteConcernWDescription = mdutils.FormatSchemaDescription(
+		`
+			Number of members, %s, or the name of a custom write concern
+			which must acknowledge the writes.
+		`,
+		mdutils.InlineCodeBlock(mongoclient.WriteConcernMajority),
+	)
+	WriteConcernJournalDescription = "Whether to request acknowledgment that the writes are written to the on-disk journal."
+)
+
+// TagSetsType is the type of the tag_sets attribute.
+var TagSetsType = types.ListType{
+	ElemType: types.MapType{ElemType: types.StringType},
+}
+
+// ResourceReadPreferenceBlock returns the read_preference block
+// overriding the read preference of the provider for a resource.
+func ResourceReadPreferenceBlock() resourceschema.Block {
+	return resourceschema.SingleNestedBlock{
+		MarkdownDescription: mdutils.FormatSchemaDescription(`
+			[Read preference](https://www.mongodb.com/docs/manual/core/read-preference/)
+			of the operations of this resource.
+			It overrides the read preference of the provider.
+		`),
+		Attributes: map[string]resourceschema.Attribute{
+			"mode": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: ReadPreferenceModeDescription,
+				Validators:          []validator.String{IsReadPreferenceMode()},
+			},
+			"tag_sets": resourceschema.ListAttribute{
+				Optional:            true,
+				ElementType:         TagSetsType.ElemType,
+				MarkdownDescription: TagSetsDescription,
+			},
+			"max_staleness": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: MaxStalenessDescription,
+				Validators:          []validator.String{IsMaxStaleness()},
+			},
+		},
+	}
+}
+
+// ResourceReadConcernAttribute returns the read_concern attribute
+// overriding the read concern of the provider for a resource.
+func ResourceReadConcernAttribute() resourceschema.Attribute {
+	return resourceschema.StringAttribute{
+		Optional: true,
+		MarkdownDescription: mdutils.FormatSchemaDescription(
+			`
+				[Read concern](https://www.mongodb.com/docs/manual/reference/read-concern/) level
+				of the operations of this resource, which is one of %s.
+				It overrides the read concern of the provider.
+			`,
+			mdutils.InlineCodeBlocks(mongoclient.ReadConcernLevels),
+		),
+		Validators: []validator.String{IsReadConcernLevel()},
+	}
+}
+
+// ResourceWriteConcernBlock returns the write_concern block
+// overriding the write concern of the provider for a resource.
+func ResourceWriteConcernBlock() resourceschema.Block {
+	return resourceschema.SingleNestedBlock{
+		MarkdownDescription: mdutils.FormatSchemaDescription(`
+			[Write concern](https://www.mongodb.com/docs/manual/reference/write-concern/)
+			of the operations of this resource.
+			It overrides the write concern of the provider.
+		`),
+		Attributes: map[string]resourceschema.Attribute{
+			"w": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: WriteConcernWDescription,
+			},
+			"journal": resourceschema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: WriteConcernJournalDescription,
+			},
+		},
+	}
+}
+
+// DataSourceReadPreferenceBlock returns the read_preference block
+// overriding the read preference of the provider for a data source.
+func DataSourceReadPreferenceBlock() datasourceschema.Block {
+	return datasourceschema.SingleNestedBlock{
+		MarkdownDescription: mdutils.FormatSchemaDescription(`
+			[Read preference](https://www.mongodb.com/docs/manual/core/read-preference/)
+			of the read operation of this data source.
+			It overrides the read preference of the provider.
+		`),
+		Attributes: map[string]datasourceschema.Attribute{
+			"mode": datasourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: ReadPreferenceModeDescription,
+				Validators:          []validator.String{IsReadPreferenceMode()},
+			},
+			"tag_sets": datasourceschema.ListAttribute{
+				Optional:            true,
+				ElementType:         TagSetsType.ElemType,
+				MarkdownDescription: TagSetsDescription,
+			},
+			"max_staleness": datasourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: MaxStalenessDescription,
+				Validators:          []validator.String{IsMaxStaleness()},
+			},
+		},
+	}
+}
+
+// DataSourceReadConcernAttribute returns the read_concern attribute
+// overriding the read concern of the provider for a data source.
+func DataSourceReadConcernAttribute() datasourceschema.Attribute {
+	return datasourceschema.StringAttribute{
+		Optional: true,
+		MarkdownDescription: mdutils.FormatSchemaDescription(
+			`
+				[Read concern](https://www.mongodb.com/docs/manual/reference/read-concern/) level
+				of the read operation of this data source, which is one of %s.
+				It overrides the read concern of the provider.
+			`,
+			mdutils.InlineCodeBlocks(mongoclient.ReadConcernLevels),
+		),
+		Validators: []validator.String{IsReadConcernLevel()},
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceconcern
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type isReadPreferenceMode struct {
+	validator.String
+}
+
+func IsReadPreferenceMode() validator.String {
+	return &isReadPreferenceMode{}
+}
+
+func (v *isReadPreferenceMode) Description(context.Context) string {
+	return readPreferenceModeDescription
+}
+
+func (v *isReadPreferenceMode) MarkdownDescription(context.Context) string {
+	return readPreferenceModeDescription
+}
+
+func (v *isReadPreferenceMode) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(mongoclient.ReadPreferenceModes, req.ConfigValue.ValueString()) {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(readPreferenceModeDescription).ToDiagnostic(),
+	)
+}
+
+type isReadConcernLevel struct {
+	validator.String
+}
+
+func IsReadConcernLevel() validator.String {
+	return &isReadConcernLevel{}
+}
+
+func (v *isReadConcernLevel) Description(context.Context) string {
+	return readConcernDescription
+}
+
+func (v *isReadConcernLevel) MarkdownDescription(context.Context) string {
+	return readConcernDescription
+}
+
+func (v *isReadConcernLevel) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(mongoclient.ReadConcernLevels, req.ConfigValue.ValueString()) {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(readConcernDescription).ToDiagnostic(),
+	)
+}
+
+type isMaxStaleness struct {
+	validator.String
+}
+
+func IsMaxStaleness() validator.String {
+	return &isMaxStaleness{}
+}
+
+func (v *isMaxStaleness) Description(context.Context) string {
+	return maxStalenessDescription
+}
+
+func (v *isMaxStaleness) MarkdownDescription(context.Context) string {
+	return maxStalenessDescription
+}
+
+func (v *isMaxStaleness) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if duration, err := time.ParseDuration(req.ConfigValue.ValueString()); err == nil && duration >= MinMaxStaleness {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(maxStalenessDescription).ToDiagnostic(),
+	)
+}
@@ -5,6 +5,7 @@ package resourceconfig
 
 import (
 	"fmt"
+	"time"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
@@ -13,8 +14,14 @@ import (
 )
 
 type ResourceConfig struct {
-	ClientConfig *mongoclient.Config
-	Logger       *zap.Logger
+	// Client is shared by every resource and data source
+	// configured by the same provider instance.
+	Client *mongoclient.SharedClient
+	Logger *zap.Logger
+
+	// OperationTimeout bounds every operation
+	// unless a timeouts block of the resource overrides it.
+	OperationTimeout time.Duration
 }
 
 func FromProviderData(data any) (config *ResourceConfig, diags diag.Diagnostics) {
@@ -34,10 +41,10 @@ func FromProviderData(data any) (config *ResourceConfig, diags diag.Diagnostics)
 		return nil, diags
 	}
 
-	if providerData.ClientConfig == nil {
+	if providerData.Client == nil {
 		diags.Append(
 			errs.NewUnexpectedResourceConfigurationType(
-				"*mongoclient.Config",
+				"*mongoclient.SharedClient",
 				fmt.Sprintf("%T", nil),
 			).ToDiagnostic(),
 		)
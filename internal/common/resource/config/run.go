@@ -0,0 +1,52 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceconfig
+
+import (
+	"context"
+	"time"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.uber.org/zap"
+)
+
+// OperationLogger returns the logger of the operation, bound to the context
+// and annotated with the operation and the fields identifying its target.
+func (c *ResourceConfig) OperationLogger(ctx context.Context, operation string, fields ...zap.Field) *zap.Logger {
+	return logging.WithContext(c.Logger, ctx).With(
+		append([]zap.Field{zap.String(logging.KeyOperation, operation)}, fields...)...,
+	)
+}
+
+// Run acquires a client from the shared client and passes it to the callback,
+// logging the start and the outcome of the operation with the fields.
+// The client and the driver monitors log with the fields as well.
+func (c *ResourceConfig) Run(ctx context.Context, operation string, fields []zap.Field, diags *diag.Diagnostics, callback func(client *mongoclient.MongoClient, err error)) {
+	logger := c.OperationLogger(ctx, operation, fields...)
+	logger.Debug("starting the operation")
+
+	// Let the driver monitors log with the fields as well
+	ctx = logging.NewContext(ctx, logger)
+
+	start := time.Now()
+	c.Client.Run(ctx, func(client *mongoclient.MongoClient, err error) {
+		if client != nil {
+			client = client.WithLogger(logger)
+		}
+		callback(client, err)
+	})
+
+	elapsed := zap.Duration("elapsed", time.Since(start))
+	if diags.HasError() {
+		var summaries []string
+		for _, d := range diags.Errors() {
+			summaries = append(summaries, d.Summary())
+		}
+		logger.Debug("the operation failed", elapsed, zap.Strings("errors", summaries))
+		return
+	}
+	logger.Debug("the operation completed", elapsed)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceconfig
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// TimeoutFunc returns the timeout configured in a timeouts block,
+// or the default timeout if it is not configured.
+// The methods of timeouts.Value (e.g. Create) satisfy it.
+type TimeoutFunc func(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics)
+
+// WithTimeout returns a context which expires after the timeout of the operation,
+// which defaults to the operation timeout of the provider if timeout is nil or not configured.
+// The context never expires if the resulting timeout is not positive.
+//
+// The returned function must be called once the operation is done. If the context
+// has expired by then, the errors in diags are replaced with a timeout error.
+func (c *ResourceConfig) WithTimeout(ctx context.Context, operation string, timeout TimeoutFunc, diags *diag.Diagnostics) (context.Context, func()) {
+	duration := c.OperationTimeout
+	if timeout != nil {
+		configured, d := timeout(ctx, duration)
+		diags.Append(d...)
+		if !d.HasError() {
+			duration = configured
+		}
+	}
+
+	// Operations are not bounded without a timeout
+	if duration <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	return ctx, func() {
+		defer cancel()
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) || !diags.HasError() {
+			return
+		}
+
+		// Replace the errors caused by the expired context
+		// with a single error explaining the timeout
+		var kept diag.Diagnostics
+		var causes []string
+		for _, d := range *diags {
+			if d.Severity() == diag.SeverityError {
+				causes = append(causes, d.Detail())
+				continue
+			}
+			kept.Append(d)
+		}
+		kept.Append(
+			errs.NewOperationTimeout(operation, duration, strings.Join(causes, "\n")).ToDiagnostic(),
+		)
+		*diags = kept
+	}
+}
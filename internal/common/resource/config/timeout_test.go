@@ -0,0 +1,102 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceconfig_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type WithTimeoutTestCase struct {
+	name             string
+	operationTimeout time.Duration
+	timeout          resourceconfig.TimeoutFunc
+	expire           bool
+	expectDeadline   bool
+	expectTimeoutErr bool
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	configured := func(timeout time.Duration) resourceconfig.TimeoutFunc {
+		return func(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+			return timeout, nil
+		}
+	}
+	invalid := func(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		diags.AddError("Invalid Timeout", "invalid duration")
+		return 0, diags
+	}
+
+	tests := []WithTimeoutTestCase{
+		{
+			name:             "provider-timeout",
+			operationTimeout: time.Hour,
+			expectDeadline:   true,
+		},
+		{
+			name:             "resource-timeout",
+			operationTimeout: time.Hour,
+			timeout:          configured(time.Minute),
+			expectDeadline:   true,
+		},
+		{
+			name: "no-timeout",
+		},
+		{
+			name:             "expired",
+			operationTimeout: time.Nanosecond,
+			expire:           true,
+			expectDeadline:   true,
+			expectTimeoutErr: true,
+		},
+		{
+			name:             "invalid-timeout",
+			operationTimeout: time.Hour,
+			timeout:          invalid,
+			expectDeadline:   true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := &resourceconfig.ResourceConfig{OperationTimeout: testCase.operationTimeout}
+
+			var diags diag.Diagnostics
+			ctx, done := config.WithTimeout(context.Background(), "create", testCase.timeout, &diags)
+
+			if _, ok := ctx.Deadline(); ok != testCase.expectDeadline {
+				t.Errorf("expected deadline %v, got %v", testCase.expectDeadline, ok)
+			}
+
+			if testCase.expire {
+				<-ctx.Done()
+				if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					t.Fatalf("expected the deadline to be exceeded, got %v", ctx.Err())
+				}
+				diags.AddError("Mongo Client Error", ctx.Err().Error())
+			}
+			done()
+
+			hasTimeoutErr := false
+			for _, d := range diags.Errors() {
+				hasTimeoutErr = hasTimeoutErr || d.Summary() == errs.NewOperationTimeout("", 0, "").Name()
+			}
+			if hasTimeoutErr != testCase.expectTimeoutErr {
+				t.Errorf("expected timeout error %v, got %v: %v", testCase.expectTimeoutErr, hasTimeoutErr, diags)
+			}
+			if testCase.expectTimeoutErr && diags.ErrorsCount() != 1 {
+				t.Errorf("expected the errors to be replaced, got %v", diags)
+			}
+		})
+	}
+}
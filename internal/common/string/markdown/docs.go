@@ -53,3 +53,13 @@ func CodeBlock(language string, code string) string {
 func InlineCodeBlock(code string) string {
 	return fmt.Sprintf("<code>%s</code>", code)
 }
+
+// InlineCodeBlocks formats every value as inline code
+// and joins them into a comma separated list.
+func InlineCodeBlocks(values []string) string {
+	blocks := make([]string, len(values))
+	for i, value := range values {
+		blocks[i] = InlineCodeBlock(value)
+	}
+	return strings.Join(blocks, ", ")
+}
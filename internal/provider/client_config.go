@@ -0,0 +1,312 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	resourceconcern "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/concern"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TLSModel describes the tls block of the provider data model.
+type TLSModel struct {
+	CACertificate         types.String `tfsdk:"ca_certificate"`
+	CACertificateFile     types.String `tfsdk:"ca_certificate_file"`
+	ClientCertificate     types.String `tfsdk:"client_certificate"`
+	ClientCertificateFile types.String `tfsdk:"client_certificate_file"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	ClientKeyFile         types.String `tfsdk:"client_key_file"`
+	ClientKeyPassword     types.String `tfsdk:"client_key_password"`
+	InsecureSkipVerify    types.Bool   `tfsdk:"insecure_skip_verify"`
+	ServerName            types.String `tfsdk:"server_name"`
+}
+
+// SSHTunnelModel describes the ssh_tunnel block of the provider data model.
+type SSHTunnelModel struct {
+	Host                  types.String `tfsdk:"host"`
+	Port                  types.Int64  `tfsdk:"port"`
+	User                  types.String `tfsdk:"user"`
+	PrivateKey            types.String `tfsdk:"private_key"`
+	PrivateKeyFile        types.String `tfsdk:"private_key_file"`
+	PrivateKeyPassword    types.String `tfsdk:"private_key_password"`
+	UseAgent              types.Bool   `tfsdk:"use_agent"`
+	KnownHostsFile        types.String `tfsdk:"known_hosts_file"`
+	InsecureIgnoreHostKey types.Bool   `tfsdk:"insecure_ignore_host_key"`
+}
+
+// Provider attributes corresponding to the URI options
+// which can be set apart from the connection string.
+var uriOptionAttributes = map[string]string{
+	mongoclient.URIOptionUsername:                "username",
+	mongoclient.URIOptionPassword:                "password",
+	mongoclient.URIOptionAuthSource:              "auth_source",
+	mongoclient.URIOptionAuthMechanism:           "auth_mechanism",
+	mongoclient.URIOptionAuthMechanismProperties: "auth_mechanism_properties",
+}
+
+// clientConfig builds the client configuration from the provider data model.
+func clientConfig(ctx context.Context, data *MongoProviderModel) (*mongoclient.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := &mongoclient.Config{
+		URI: data.URI.ValueString(),
+	}
+
+	// Prepare authentication options
+	auth := &mongoclient.AuthConfig{
+		Username:  data.Username.ValueString(),
+		Password:  data.Password.ValueString(),
+		Source:    data.AuthSource.ValueString(),
+		Mechanism: data.AuthMechanism.ValueString(),
+	}
+	if !data.AuthMechanismProperties.IsNull() {
+		diags.Append(data.AuthMechanismProperties.ElementsAs(ctx, &auth.MechanismProperties, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	// Report options given both in the URI and in the provider configuration
+	conflicts, err := auth.Conflicts(config.URI)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("uri"),
+			errs.InvalidProviderConfigurationName,
+			err.Error(),
+		)
+		return nil, diags
+	}
+	for _, option := range conflicts {
+		attribute := uriOptionAttributes[option]
+		diags.Append(
+			diag.WithPath(
+				path.Root(attribute),
+				errs.NewConflictingURIOption(attribute, option).ToDiagnostic(),
+			),
+		)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !auth.IsEmpty() {
+		config.Auth = auth
+	}
+
+	// Prepare TLS options
+	if data.TLS != nil {
+		config.TLS = tlsConfig(data.TLS, &diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	// Prepare proxy options
+	if proxy := data.Proxy.ValueString(); proxy != "" {
+		if _, err := mongoclient.ParseProxyURL(proxy); err != nil {
+			diags.AddAttributeError(
+				path.Root("proxy"),
+				errs.InvalidProviderConfigurationName,
+				err.Error(),
+			)
+			return nil, diags
+		}
+		config.Proxy = proxy
+	}
+
+	// Prepare retry options
+	config.Retry = retryConfig(data, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Prepare the operation timeout
+	config.OperationTimeout = operationTimeout(data, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Prepare the read preference, the read concern and the write concern
+	config.Concern = resourceconcern.ToConfig(ctx, data.ReadPreference, data.ReadConcern, data.WriteConcern, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Prepare SSH tunnel options
+	if data.SSHTunnel != nil {
+		config.SSHTunnel = sshTunnelConfig(data.SSHTunnel, &diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return config, diags
+}
+
+func retryConfig(data *MongoProviderModel, diags *diag.Diagnostics) *mongoclient.RetryConfig {
+	config := mongoclient.DefaultRetryConfig()
+
+	if !data.MaxRetries.IsNull() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			diags.AddAttributeError(
+				path.Root("max_retries"),
+				errs.InvalidProviderConfigurationName,
+				fmt.Sprintf("max_retries must not be negative, got %d.", data.MaxRetries.ValueInt64()),
+			)
+			return nil
+		}
+		config.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	if !data.RetryMaxInterval.IsNull() {
+		interval, err := time.ParseDuration(data.RetryMaxInterval.ValueString())
+		if err == nil && interval <= 0 {
+			err = errors.New("duration must be positive")
+		}
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("retry_max_interval"),
+				errs.InvalidProviderConfigurationName,
+				err.Error(),
+			)
+			return nil
+		}
+		config.MaxInterval = interval
+	}
+
+	return config
+}
+
+func operationTimeout(data *MongoProviderModel, diags *diag.Diagnostics) time.Duration {
+	// The driver is given a timeout only if it is configured, the operations
+	// are otherwise bounded by their contexts with the default timeout
+	if data.OperationTimeout.IsNull() {
+		return 0
+	}
+
+	timeout, err := time.ParseDuration(data.OperationTimeout.ValueString())
+	if err == nil && timeout <= 0 {
+		err = errors.New("duration must be positive")
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("operation_timeout"),
+			errs.InvalidProviderConfigurationName,
+			err.Error(),
+		)
+		return 0
+	}
+	return timeout
+}
+
+func sshTunnelConfig(data *SSHTunnelModel, diags *diag.Diagnostics) *mongoclient.SSHTunnelConfig {
+	root := path.Root("ssh_tunnel")
+
+	port := int64(mongoclient.DefaultSSHPort)
+	if !data.Port.IsNull() {
+		port = data.Port.ValueInt64()
+	}
+	if port < 1 || port > 65535 {
+		diags.AddAttributeError(
+			root.AtName("port"),
+			errs.InvalidProviderConfigurationName,
+			fmt.Sprintf("Port must be between 1 and 65535, got %d.", port),
+		)
+		return nil
+	}
+
+	config := &mongoclient.SSHTunnelConfig{
+		Host: data.Host.ValueString(),
+		Port: int(port),
+		User: data.User.ValueString(),
+		PrivateKey: readPEM(
+			root.AtName("private_key"), data.PrivateKey,
+			root.AtName("private_key_file"), data.PrivateKeyFile,
+			diags,
+		),
+		PrivateKeyPassword:    data.PrivateKeyPassword.ValueString(),
+		UseAgent:              data.UseAgent.ValueBool(),
+		InsecureIgnoreHostKey: data.InsecureIgnoreHostKey.ValueBool(),
+	}
+	if file := data.KnownHostsFile.ValueString(); file != "" {
+		config.KnownHostsFiles = []string{file}
+	}
+
+	if len(config.PrivateKey) == 0 && !config.UseAgent && !diags.HasError() {
+		diags.AddAttributeError(
+			root,
+			errs.InvalidProviderConfigurationName,
+			"Either a private key or use_agent is required to open the SSH tunnel.",
+		)
+	}
+
+	return config
+}
+
+func tlsConfig(data *TLSModel, diags *diag.Diagnostics) *mongoclient.TLSConfig {
+	root := path.Root("tls")
+	return &mongoclient.TLSConfig{
+		CA: readPEM(
+			root.AtName("ca_certificate"), data.CACertificate,
+			root.AtName("ca_certificate_file"), data.CACertificateFile,
+			diags,
+		),
+		ClientCertificate: readPEM(
+			root.AtName("client_certificate"), data.ClientCertificate,
+			root.AtName("client_certificate_file"), data.ClientCertificateFile,
+			diags,
+		),
+		ClientKey: readPEM(
+			root.AtName("client_key"), data.ClientKey,
+			root.AtName("client_key_file"), data.ClientKeyFile,
+			diags,
+		),
+		ClientKeyPassword:  data.ClientKeyPassword.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		ServerName:         data.ServerName.ValueString(),
+	}
+}
+
+// readPEM returns PEM content given either inline or as a path to a file.
+func readPEM(inlinePath path.Path, inline types.String, filePath path.Path, file types.String, diags *diag.Diagnostics) []byte {
+	hasInline := inline.ValueString() != ""
+	hasFile := file.ValueString() != ""
+
+	if hasInline && hasFile {
+		diags.AddAttributeError(
+			inlinePath,
+			errs.InvalidProviderConfigurationName,
+			fmt.Sprintf("Only one of %s and %s can be set.", inlinePath, filePath),
+		)
+		return nil
+	}
+
+	if hasFile {
+		content, err := os.ReadFile(file.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				filePath,
+				errs.InvalidProviderConfigurationName,
+				err.Error(),
+			)
+			return nil
+		}
+		return content
+	}
+
+	if hasInline {
+		return []byte(inline.ValueString())
+	}
+
+	return nil
+}
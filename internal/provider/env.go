@@ -0,0 +1,324 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	resourceconcern "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/concern"
+	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Environment variables used when the corresponding
+// provider attributes are not set in the configuration.
+const (
+	EnvURI                     = "MONGODB_URI"
+	EnvUsername                = "MONGODB_USERNAME"
+	EnvPassword                = "MONGODB_PASSWORD"
+	EnvAuthSource              = "MONGODB_AUTH_SOURCE"
+	EnvAuthMechanism           = "MONGODB_AUTH_MECHANISM"
+	EnvAuthMechanismProperties = "MONGODB_AUTH_MECHANISM_PROPERTIES"
+	EnvProxy                   = "MONGODB_PROXY"
+	EnvMaxRetries              = "MONGODB_MAX_RETRIES"
+	EnvRetryMaxInterval        = "MONGODB_RETRY_MAX_INTERVAL"
+	EnvOperationTimeout        = "MONGODB_OPERATION_TIMEOUT"
+	EnvReadConcern             = "MONGODB_READ_CONCERN"
+
+	EnvReadPreferenceMode         = "MONGODB_READ_PREFERENCE_MODE"
+	EnvReadPreferenceMaxStaleness = "MONGODB_READ_PREFERENCE_MAX_STALENESS"
+
+	EnvWriteConcernW       = "MONGODB_WRITE_CONCERN_W"
+	EnvWriteConcernJournal = "MONGODB_WRITE_CONCERN_JOURNAL"
+
+	EnvTLSCACertificate         = "MONGODB_TLS_CA_CERTIFICATE"
+	EnvTLSCACertificateFile     = "MONGODB_TLS_CA_CERTIFICATE_FILE"
+	EnvTLSClientCertificate     = "MONGODB_TLS_CLIENT_CERTIFICATE"
+	EnvTLSClientCertificateFile = "MONGODB_TLS_CLIENT_CERTIFICATE_FILE"
+	EnvTLSClientKey             = "MONGODB_TLS_CLIENT_KEY"
+	EnvTLSClientKeyFile         = "MONGODB_TLS_CLIENT_KEY_FILE"
+	EnvTLSClientKeyPassword     = "MONGODB_TLS_CLIENT_KEY_PASSWORD"
+	EnvTLSInsecureSkipVerify    = "MONGODB_TLS_INSECURE_SKIP_VERIFY"
+	EnvTLSServerName            = "MONGODB_TLS_SERVER_NAME"
+
+	EnvSSHTunnelHost                  = "MONGODB_SSH_TUNNEL_HOST"
+	EnvSSHTunnelPort                  = "MONGODB_SSH_TUNNEL_PORT"
+	EnvSSHTunnelUser                  = "MONGODB_SSH_TUNNEL_USER"
+	EnvSSHTunnelPrivateKey            = "MONGODB_SSH_TUNNEL_PRIVATE_KEY"
+	EnvSSHTunnelPrivateKeyFile        = "MONGODB_SSH_TUNNEL_PRIVATE_KEY_FILE"
+	EnvSSHTunnelPrivateKeyPassword    = "MONGODB_SSH_TUNNEL_PRIVATE_KEY_PASSWORD"
+	EnvSSHTunnelUseAgent              = "MONGODB_SSH_TUNNEL_USE_AGENT"
+	EnvSSHTunnelKnownHostsFile        = "MONGODB_SSH_TUNNEL_KNOWN_HOSTS_FILE"
+	EnvSSHTunnelInsecureIgnoreHostKey = "MONGODB_SSH_TUNNEL_INSECURE_IGNORE_HOST_KEY"
+)
+
+var tlsEnvs = []string{
+	EnvTLSCACertificate,
+	EnvTLSCACertificateFile,
+	EnvTLSClientCertificate,
+	EnvTLSClientCertificateFile,
+	EnvTLSClientKey,
+	EnvTLSClientKeyFile,
+	EnvTLSClientKeyPassword,
+	EnvTLSInsecureSkipVerify,
+	EnvTLSServerName,
+}
+
+var sshTunnelEnvs = []string{
+	EnvSSHTunnelHost,
+	EnvSSHTunnelPort,
+	EnvSSHTunnelUser,
+	EnvSSHTunnelPrivateKey,
+	EnvSSHTunnelPrivateKeyFile,
+	EnvSSHTunnelPrivateKeyPassword,
+	EnvSSHTunnelUseAgent,
+	EnvSSHTunnelKnownHostsFile,
+	EnvSSHTunnelInsecureIgnoreHostKey,
+}
+
+var readPreferenceEnvs = []string{
+	EnvReadPreferenceMode,
+	EnvReadPreferenceMaxStaleness,
+}
+
+var writeConcernEnvs = []string{
+	EnvWriteConcernW,
+	EnvWriteConcernJournal,
+}
+
+// applyEnvironment fills the attributes missing in the configuration
+// with the values of the corresponding environment variables.
+//
+// Attributes set in the configuration always take precedence,
+// and the environment variables are ignored for them.
+func applyEnvironment(data *MongoProviderModel, diags *diag.Diagnostics) {
+	stringFromEnv(&data.URI, EnvURI)
+	stringFromEnv(&data.Username, EnvUsername)
+	stringFromEnv(&data.Password, EnvPassword)
+	stringFromEnv(&data.AuthSource, EnvAuthSource)
+	stringFromEnv(&data.AuthMechanism, EnvAuthMechanism)
+	mapFromEnv(&data.AuthMechanismProperties, path.Root("auth_mechanism_properties"), EnvAuthMechanismProperties, diags)
+	stringFromEnv(&data.Proxy, EnvProxy)
+	int64FromEnv(&data.MaxRetries, path.Root("max_retries"), EnvMaxRetries, diags)
+	stringFromEnv(&data.RetryMaxInterval, EnvRetryMaxInterval)
+	stringFromEnv(&data.OperationTimeout, EnvOperationTimeout)
+	stringFromEnv(&data.ReadConcern, EnvReadConcern)
+
+	// The TLS block is enabled by the environment variables
+	// only if it is absent in the configuration.
+	if data.TLS == nil && anyEnv(tlsEnvs...) {
+		data.TLS = &TLSModel{
+			CACertificate:         types.StringNull(),
+			CACertificateFile:     types.StringNull(),
+			ClientCertificate:     types.StringNull(),
+			ClientCertificateFile: types.StringNull(),
+			ClientKey:             types.StringNull(),
+			ClientKeyFile:         types.StringNull(),
+			ClientKeyPassword:     types.StringNull(),
+			InsecureSkipVerify:    types.BoolNull(),
+			ServerName:            types.StringNull(),
+		}
+	}
+	if data.TLS != nil {
+		tls := data.TLS
+
+		// Content and file path are mutually exclusive, so the environment
+		// variables are ignored if either of them is configured.
+		pemFromEnv(&tls.CACertificate, EnvTLSCACertificate, &tls.CACertificateFile, EnvTLSCACertificateFile)
+		pemFromEnv(&tls.ClientCertificate, EnvTLSClientCertificate, &tls.ClientCertificateFile, EnvTLSClientCertificateFile)
+		pemFromEnv(&tls.ClientKey, EnvTLSClientKey, &tls.ClientKeyFile, EnvTLSClientKeyFile)
+		stringFromEnv(&tls.ClientKeyPassword, EnvTLSClientKeyPassword)
+		stringFromEnv(&tls.ServerName, EnvTLSServerName)
+		boolFromEnv(&tls.InsecureSkipVerify, path.Root("tls").AtName("insecure_skip_verify"), EnvTLSInsecureSkipVerify, diags)
+	}
+
+	// Likewise, the SSH tunnel block
+	if data.SSHTunnel == nil && anyEnv(sshTunnelEnvs...) {
+		data.SSHTunnel = &SSHTunnelModel{
+			Host:                  types.StringNull(),
+			Port:                  types.Int64Null(),
+			User:                  types.StringNull(),
+			PrivateKey:            types.StringNull(),
+			PrivateKeyFile:        types.StringNull(),
+			PrivateKeyPassword:    types.StringNull(),
+			UseAgent:              types.BoolNull(),
+			KnownHostsFile:        types.StringNull(),
+			InsecureIgnoreHostKey: types.BoolNull(),
+		}
+	}
+	if data.SSHTunnel != nil {
+		tunnel := data.SSHTunnel
+
+		stringFromEnv(&tunnel.Host, EnvSSHTunnelHost)
+		int64FromEnv(&tunnel.Port, path.Root("ssh_tunnel").AtName("port"), EnvSSHTunnelPort, diags)
+		stringFromEnv(&tunnel.User, EnvSSHTunnelUser)
+		pemFromEnv(&tunnel.PrivateKey, EnvSSHTunnelPrivateKey, &tunnel.PrivateKeyFile, EnvSSHTunnelPrivateKeyFile)
+		stringFromEnv(&tunnel.PrivateKeyPassword, EnvSSHTunnelPrivateKeyPassword)
+		boolFromEnv(&tunnel.UseAgent, path.Root("ssh_tunnel").AtName("use_agent"), EnvSSHTunnelUseAgent, diags)
+		stringFromEnv(&tunnel.KnownHostsFile, EnvSSHTunnelKnownHostsFile)
+		boolFromEnv(&tunnel.InsecureIgnoreHostKey, path.Root("ssh_tunnel").AtName("insecure_ignore_host_key"), EnvSSHTunnelInsecureIgnoreHostKey, diags)
+	}
+
+	// Likewise, the read preference and the write concern blocks
+	if data.ReadPreference == nil && anyEnv(readPreferenceEnvs...) {
+		data.ReadPreference = &resourceconcern.ReadPreferenceModel{
+			Mode:         types.StringNull(),
+			TagSets:      types.ListNull(resourceconcern.TagSetsType.ElemType),
+			MaxStaleness: types.StringNull(),
+		}
+	}
+	if data.ReadPreference != nil {
+		stringFromEnv(&data.ReadPreference.Mode, EnvReadPreferenceMode)
+		stringFromEnv(&data.ReadPreference.MaxStaleness, EnvReadPreferenceMaxStaleness)
+	}
+	if data.WriteConcern == nil && anyEnv(writeConcernEnvs...) {
+		data.WriteConcern = &resourceconcern.WriteConcernModel{
+			W:       types.StringNull(),
+			Journal: types.BoolNull(),
+		}
+	}
+	if data.WriteConcern != nil {
+		stringFromEnv(&data.WriteConcern.W, EnvWriteConcernW)
+		boolFromEnv(&data.WriteConcern.Journal, path.Root("write_concern").AtName("journal"), EnvWriteConcernJournal, diags)
+	}
+}
+
+func anyEnv(names ...string) bool {
+	for _, name := range names {
+		if os.Getenv(name) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func stringFromEnv(value *types.String, name string) {
+	if !value.IsNull() {
+		return
+	}
+	if env := os.Getenv(name); env != "" {
+		*value = types.StringValue(env)
+	}
+}
+
+func pemFromEnv(content *types.String, contentName string, file *types.String, fileName string) {
+	if !content.IsNull() || !file.IsNull() {
+		return
+	}
+	stringFromEnv(content, contentName)
+	stringFromEnv(file, fileName)
+}
+
+func boolFromEnv(value *types.Bool, attribute path.Path, name string, diags *diag.Diagnostics) {
+	env := os.Getenv(name)
+	if !value.IsNull() || env == "" {
+		return
+	}
+
+	parsed, err := strconv.ParseBool(env)
+	if err != nil {
+		diags.AddAttributeError(
+			attribute,
+			errs.InvalidProviderConfigurationName,
+			fmt.Sprintf("%s must be a boolean, got %q", name, env),
+		)
+		return
+	}
+	*value = types.BoolValue(parsed)
+}
+
+func int64FromEnv(value *types.Int64, attribute path.Path, name string, diags *diag.Diagnostics) {
+	env := os.Getenv(name)
+	if !value.IsNull() || env == "" {
+		return
+	}
+
+	parsed, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		diags.AddAttributeError(
+			attribute,
+			errs.InvalidProviderConfigurationName,
+			fmt.Sprintf("%s must be an integer, got %q", name, env),
+		)
+		return
+	}
+	*value = types.Int64Value(parsed)
+}
+
+// mapFromEnv parses a map in the format of the connection string,
+// which is a comma separated list of colon separated key-value pairs.
+// (e.g. KEY1:value1,KEY2:value2)
+func mapFromEnv(value *types.Map, attribute path.Path, name string, diags *diag.Diagnostics) {
+	env := os.Getenv(name)
+	if !value.IsNull() || env == "" {
+		return
+	}
+
+	elements := map[string]attr.Value{}
+	for _, pair := range strings.Split(env, ",") {
+		key, val, ok := strings.Cut(pair, ":")
+		if !ok || key == "" {
+			diags.AddAttributeError(
+				attribute,
+				errs.InvalidProviderConfigurationName,
+				fmt.Sprintf("%s must be a comma separated list of KEY:value pairs, got %q", name, env),
+			)
+			return
+		}
+		elements[key] = types.StringValue(val)
+	}
+
+	parsed, d := types.MapValue(types.StringType, elements)
+	for _, diagnostic := range d {
+		diags.Append(diag.WithPath(attribute, diagnostic))
+	}
+	if d.HasError() {
+		return
+	}
+	*value = parsed
+}
+
+// envDescription appends the environment variable to the attribute description.
+func envDescription(description string, env string) string {
+	return fmt.Sprintf("%s Can also be set with the %s environment variable.", description, mdutils.InlineCodeBlock(env))
+}
+
+// checkRequired reports the attributes which are
+// neither configured nor set by the environment variables.
+func checkRequired(data *MongoProviderModel, diags *diag.Diagnostics) {
+	if data.URI.IsNull() || data.URI.ValueString() == "" {
+		diags.Append(
+			diag.WithPath(
+				path.Root("uri"),
+				errs.NewMissingConnectionURI(EnvURI).ToDiagnostic(),
+			),
+		)
+	}
+
+	if tunnel := data.SSHTunnel; tunnel != nil {
+		required := []struct {
+			name  string
+			value types.String
+		}{
+			{"host", tunnel.Host},
+			{"user", tunnel.User},
+		}
+		for _, attribute := range required {
+			if attribute.value.ValueString() == "" {
+				diags.AddAttributeError(
+					path.Root("ssh_tunnel").AtName(attribute.name),
+					errs.InvalidProviderConfigurationName,
+					fmt.Sprintf("%s is required to open the SSH tunnel.", attribute.name),
+				)
+			}
+		}
+	}
+}
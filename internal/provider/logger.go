@@ -4,18 +4,29 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/versions"
 	"go.uber.org/zap"
 )
 
-func configureLogger(p *MongoProvider) (*zap.Logger, error) {
+// configureLogger returns the logger of the provider, which masks the secrets.
+// Outside of development, the entries go to the provider logger of tflog,
+// so they are controlled by the TF_LOG and TF_LOG_PROVIDER environment variables,
+// and the entries of the levels they leave out are not built at all.
+func configureLogger(ctx context.Context, p *MongoProvider, secrets []string) (*zap.Logger, error) {
 	if p.config.Logger != nil {
-		return p.config.Logger, nil
+		return logging.WithMask(p.config.Logger, secrets...), nil
 	}
 
 	if p.version == versions.Dev {
-		return zap.NewDevelopment()
+		logger, err := zap.NewDevelopment()
+		if err != nil {
+			return nil, err
+		}
+		return logging.WithMask(logger, secrets...), nil
 	}
 
-	return zap.NewNop(), nil
+	return logging.WithMask(zap.New(logging.NewTFLogCore(ctx, logging.TFLogLevel(providerTypeName))), secrets...), nil
 }
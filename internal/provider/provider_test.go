@@ -0,0 +1,448 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/provider"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/acc"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/mongolocal"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/proxyserver"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/sshserver"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/tlscert"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccProvider_TLS(t *testing.T) {
+	t.Parallel()
+
+	certificates, err := tlscert.Generate(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to generate certificates: %v", err)
+	}
+
+	config := mongolocal.DefaultConfig()
+	config.TLS = &mongolocal.TLSConfig{
+		CertificateKeyFile: certificates.ServerFile,
+		CAFile:             certificates.CAFile,
+	}
+
+	mongolocal.RunWithServerConfig(t, config, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		logger.Info("running the test...")
+
+		databaseResource := `
+			resource "mongodb_database" "test" {
+				name = "test-database"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Both inline content and file path are given
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri = "%s"
+						tls {
+							ca_certificate      = file("%s")
+							ca_certificate_file = "%s"
+						}
+					`, server.URI(), certificates.CAFile, certificates.CAFile)) + databaseResource,
+					ExpectError: regexp.MustCompile(errs.NewInvalidProviderConfiguration("").Name()),
+				},
+				// Connect with certificates given as file paths
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri = "%s"
+						tls {
+							ca_certificate_file     = "%s"
+							client_certificate_file = "%s"
+							client_key_file         = "%s"
+						}
+					`, server.URI(), certificates.CAFile, certificates.ClientCertificateFile, certificates.ClientKeyFile)) + databaseResource,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database.test", "id", "databases/test-database"),
+					),
+				},
+				// Connect with inline certificates and an encrypted key
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri = "%s"
+						tls {
+							ca_certificate      = file("%s")
+							client_certificate  = file("%s")
+							client_key          = file("%s")
+							client_key_password = "%s"
+						}
+					`, server.URI(), certificates.CAFile, certificates.ClientCertificateFile, certificates.EncryptedClientKeyFile, tlscert.ClientKeyPassword)) + databaseResource,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database.test", "id", "databases/test-database"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccProvider_Environment(t *testing.T) {
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		logger.Info("running the test...")
+
+		databaseResource := `
+			resource "mongodb_database" "test" {
+				name = "test-database"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Neither the attribute nor the environment variable is set
+				{
+					PreConfig: func() {
+						t.Setenv(provider.EnvURI, "")
+					},
+					Config:      acc.CustomProviderConfig("") + databaseResource,
+					ExpectError: regexp.MustCompile(errs.NewMissingConnectionURI("").Name()),
+				},
+				// Connect with the URI from the environment variable
+				{
+					PreConfig: func() {
+						t.Setenv(provider.EnvURI, server.URI())
+					},
+					Config: acc.CustomProviderConfig("") + databaseResource,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database.test", "id", "databases/test-database"),
+					),
+				},
+				// The attribute takes precedence over the environment variable
+				{
+					PreConfig: func() {
+						t.Setenv(provider.EnvURI, "mongodb://invalid-host.invalid:27017/?serverSelectionTimeoutMS=1000")
+					},
+					Config: acc.ProviderConfig(server.URI()) + databaseResource,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database.test", "id", "databases/test-database"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccProvider_SSHTunnel(t *testing.T) {
+	t.Parallel()
+
+	bastion, err := sshserver.Start(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to start the SSH server: %v", err)
+	}
+	t.Cleanup(func() { bastion.Close() })
+
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		logger.Info("running the test...")
+
+		databaseResource := `
+			resource "mongodb_database" "test" {
+				name = "test-database"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// The host of the bastion is missing
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri = "%s"
+						ssh_tunnel {
+							user             = "%s"
+							private_key_file = "%s"
+						}
+					`, server.URI(), sshserver.User, bastion.ClientKeyFile)) + databaseResource,
+					ExpectError: regexp.MustCompile(errs.NewInvalidProviderConfiguration("").Name()),
+				},
+				// Connect through the bastion
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri = "%s"
+						ssh_tunnel {
+							host                 = "%s"
+							port                 = %d
+							user                 = "%s"
+							private_key          = file("%s")
+							private_key_password = "%s"
+							known_hosts_file     = "%s"
+						}
+					`, server.URI(), bastion.Host, bastion.Port, sshserver.User, bastion.EncryptedClientKeyFile, sshserver.ClientKeyPassword, bastion.KnownHostsFile)) + databaseResource,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database.test", "id", "databases/test-database"),
+						func(*terraform.State) error {
+							if len(bastion.Forwarded()) == 0 {
+								return errors.New("expected the server to be dialed through the SSH tunnel")
+							}
+							return nil
+						},
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccProvider_Proxy(t *testing.T) {
+	t.Parallel()
+
+	proxy, err := proxyserver.Start(proxyserver.SOCKS5, "user", "password")
+	if err != nil {
+		t.Fatalf("failed to start the proxy: %v", err)
+	}
+	t.Cleanup(func() { proxy.Close() })
+
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		logger.Info("running the test...")
+
+		databaseResource := `
+			resource "mongodb_database" "test" {
+				name = "test-database"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Unsupported scheme
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri   = "%s"
+						proxy = "ftp://proxy.example.com"
+					`, server.URI())) + databaseResource,
+					ExpectError: regexp.MustCompile(errs.NewInvalidProviderConfiguration("").Name()),
+				},
+				// Connect through the proxy
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri   = "%s"
+						proxy = "%s"
+					`, server.URI(), proxy.URL())) + databaseResource,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database.test", "id", "databases/test-database"),
+						func(*terraform.State) error {
+							if len(proxy.Forwarded()) == 0 {
+								return errors.New("expected the server to be dialed through the proxy")
+							}
+							return nil
+						},
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccProvider_Retry(t *testing.T) {
+	t.Parallel()
+
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		logger.Info("running the test...")
+
+		databaseResource := `
+			resource "mongodb_database" "test" {
+				name = "test-database"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Negative number of retries
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri         = "%s"
+						max_retries = -1
+					`, server.URI())) + databaseResource,
+					ExpectError: regexp.MustCompile(errs.NewInvalidInputValue("").Name()),
+				},
+				// Invalid interval
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri                = "%s"
+						retry_max_interval = "ten seconds"
+					`, server.URI())) + databaseResource,
+					ExpectError: regexp.MustCompile(errs.NewInvalidInputValue("").Name()),
+				},
+				// Connect with retries configured
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri                = "%s"
+						max_retries        = 5
+						retry_max_interval = "10s"
+					`, server.URI())) + databaseResource,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database.test", "id", "databases/test-database"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccProvider_OperationTimeout(t *testing.T) {
+	t.Parallel()
+
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		logger.Info("running the test...")
+
+		databaseResource := func(timeouts string) string {
+			return fmt.Sprintf(`
+				resource "mongodb_database" "test" {
+					name = "test-database"
+
+					timeouts {
+						%s
+					}
+				}
+			`, timeouts)
+		}
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Invalid timeout
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri               = "%s"
+						operation_timeout = "ten minutes"
+					`, server.URI())) + databaseResource(""),
+					ExpectError: regexp.MustCompile(errs.NewInvalidInputValue("").Name()),
+				},
+				// Operation exceeding the timeout of the resource
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri               = "%s"
+						operation_timeout = "10m"
+					`, server.URI())) + databaseResource(`create = "1ns"`),
+					ExpectError: regexp.MustCompile(errs.NewOperationTimeout("", 0, "").Name()),
+				},
+				// Operation exceeding the timeout of the provider
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri               = "%s"
+						operation_timeout = "1ns"
+					`, server.URI())) + databaseResource(""),
+					ExpectError: regexp.MustCompile(errs.NewOperationTimeout("", 0, "").Name()),
+				},
+				// Timeout of the resource overriding the timeout of the provider
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri               = "%s"
+						operation_timeout = "1ns"
+					`, server.URI())) + databaseResource(`
+						create = "1m"
+						read   = "1m"
+						delete = "1m"
+					`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database.test", "id", "databases/test-database"),
+						resource.TestCheckResourceAttr("mongodb_database.test", "timeouts.create", "1m"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccProvider_Concern(t *testing.T) {
+	t.Parallel()
+
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		logger.Info("running the test...")
+
+		databaseResource := `
+			resource "mongodb_database" "test" {
+				name = "test-database"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Invalid read concern
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri          = "%s"
+						read_concern = "strong"
+					`, server.URI())) + databaseResource,
+					ExpectError: regexp.MustCompile(errs.NewInvalidInputValue("").Name()),
+				},
+				// Read preference without a mode
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri = "%s"
+
+						read_preference {
+							max_staleness = "2m"
+						}
+					`, server.URI())) + databaseResource,
+					ExpectError: regexp.MustCompile(errs.NewInvalidInputValue("").Name()),
+				},
+				// Connect with the concerns configured
+				{
+					Config: acc.CustomProviderConfig(fmt.Sprintf(`
+						uri          = "%s"
+						read_concern = "majority"
+
+						read_preference {
+							mode          = "secondaryPreferred"
+							tag_sets      = [{ region = "east" }, {}]
+							max_staleness = "90s"
+						}
+
+						write_concern {
+							w       = "majority"
+							journal = true
+						}
+					`, server.URI())) + databaseResource,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database.test", "id", "databases/test-database"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+)
+
+// clients keeps track of the shared clients opened by
+// the provider instances living in this process.
+var clients = &clientRegistry{
+	clients: map[*mongoclient.SharedClient]struct{}{},
+}
+
+type clientRegistry struct {
+	mu      sync.Mutex
+	clients map[*mongoclient.SharedClient]struct{}
+}
+
+func (r *clientRegistry) add(client *mongoclient.SharedClient) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.clients[client] = struct{}{}
+}
+
+func (r *clientRegistry) remove(ctx context.Context, client *mongoclient.SharedClient) error {
+	r.mu.Lock()
+	delete(r.clients, client)
+	r.mu.Unlock()
+	return client.Close(ctx)
+}
+
+// Shutdown disconnects every shared client opened by the provider
+// instances in this process. It should be called once the provider
+// server has stopped serving.
+func Shutdown(ctx context.Context) error {
+	clients.mu.Lock()
+	defer clients.mu.Unlock()
+
+	var errList []error
+	for client := range clients.clients {
+		errList = append(errList, client.Close(ctx))
+		delete(clients.clients, client)
+	}
+	return errors.Join(errList...)
+}
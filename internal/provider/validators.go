@@ -0,0 +1,115 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var authMechanismDescription = fmt.Sprintf(
+	"auth_mechanism must be one of %s",
+	strings.Join(mongoclient.AuthMechanisms, ", "),
+)
+
+type isAuthMechanism struct {
+	validator.String
+}
+
+func IsAuthMechanism() validator.String {
+	return &isAuthMechanism{}
+}
+
+func (v *isAuthMechanism) Description(context.Context) string {
+	return authMechanismDescription
+}
+
+func (v *isAuthMechanism) MarkdownDescription(context.Context) string {
+	return authMechanismDescription
+}
+
+func (v *isAuthMechanism) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(mongoclient.AuthMechanisms, req.ConfigValue.ValueString()) {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(authMechanismDescription).ToDiagnostic(),
+	)
+}
+
+const nonNegativeDescription = "value must not be negative"
+
+type isNonNegative struct {
+	validator.Int64
+}
+
+func IsNonNegative() validator.Int64 {
+	return &isNonNegative{}
+}
+
+func (v *isNonNegative) Description(context.Context) string {
+	return nonNegativeDescription
+}
+
+func (v *isNonNegative) MarkdownDescription(context.Context) string {
+	return nonNegativeDescription
+}
+
+func (v *isNonNegative) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.ValueInt64() >= 0 {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(nonNegativeDescription).ToDiagnostic(),
+	)
+}
+
+const durationDescription = `value must be a positive duration such as "500ms", "10s" or "1m"`
+
+type isDuration struct {
+	validator.String
+}
+
+func IsDuration() validator.String {
+	return &isDuration{}
+}
+
+func (v *isDuration) Description(context.Context) string {
+	return durationDescription
+}
+
+func (v *isDuration) MarkdownDescription(context.Context) string {
+	return durationDescription
+}
+
+func (v *isDuration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if duration, err := time.ParseDuration(req.ConfigValue.ValueString()); err == nil && duration > 0 {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(durationDescription).ToDiagnostic(),
+	)
+}
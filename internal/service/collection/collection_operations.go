@@ -8,9 +8,11 @@ import (
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	resourcecollation "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/collation"
 	resourceid "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/id"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/service/database"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
@@ -41,12 +43,19 @@ func CreateResourceId(database basetypes.StringValue, name basetypes.StringValue
 }
 
 func dataSourceRead(client *mongoclient.MongoClient, data *CollectionDataSourceModel) diag.Diagnostics {
+	_, diags := readOptions(client, data)
+	return diags
+}
+
+// readOptions reads the collection into the data source data
+// and returns the options of the collection.
+func readOptions(client *mongoclient.MongoClient, data *CollectionDataSourceModel) (*mongoclient.CollectionOptions, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Check if the database exists
 	database := database.CheckExistance(client, data.Database.ValueString(), &diags)
 	if diags.HasError() {
-		return diags
+		return nil, diags
 	}
 
 	// Validate collection name
@@ -55,14 +64,43 @@ func dataSourceRead(client *mongoclient.MongoClient, data *CollectionDataSourceM
 		diags.Append(
 			errs.NewInvalidCollectionName(name).ToDiagnostic(),
 		)
-		return diags
+		return nil, diags
 	}
 
 	// Check if the collection exists
-	CheckExistance(database, name, &diags)
+	collection := CheckExistance(database, name, &diags)
 	if diags.HasError() {
-		return diags
+		return nil, diags
+	}
+
+	// Read the options of the collection, which tell views from collections
+	opts, err := collection.Options()
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return nil, diags
+	}
+	if opts == nil {
+		diags.Append(
+			errs.NewCollectionNotFound(name).ToDiagnostic(),
+		)
+		return nil, diags
+	}
+	data.Type = basetypes.NewStringValue(opts.Type)
+	data.ReadOnly = basetypes.NewBoolValue(opts.ReadOnly)
+	data.UUID = basetypes.NewStringNull()
+	if opts.UUID != "" {
+		data.UUID = basetypes.NewStringValue(opts.UUID)
+	}
+	options, err := mongoclient.EJSONString(opts.Raw)
+	if err != nil {
+		diags.Append(
+			errs.NewEJsonParseError(err).ToDiagnostic(),
+		)
+		return nil, diags
 	}
+	data.Options = basetypes.NewStringValue(options)
 
 	// Set resource Id
 	resourceId, err := CreateResourceId(data.Database, data.Name)
@@ -70,11 +108,11 @@ func dataSourceRead(client *mongoclient.MongoClient, data *CollectionDataSourceM
 		diags.Append(
 			errs.NewInvalidResourceConfiguration(err.Error()).ToDiagnostic(),
 		)
-		return diags
+		return nil, diags
 	}
 	data.Id = resourceId
 
-	return diags
+	return opts, diags
 }
 
 func resourceRead(client *mongoclient.MongoClient, data *CollectionResourceModel) diag.Diagnostics {
@@ -86,16 +124,153 @@ func resourceRead(client *mongoclient.MongoClient, data *CollectionResourceModel
 	}
 
 	// Read the data source
-	diags := dataSourceRead(client, d)
+	opts, diags := readOptions(client, d)
 
 	// Convert back to resource data
 	data.Id = d.Id
 	data.Name = d.Name
 	data.Database = d.Database
 
+	if diags.HasError() {
+		return diags
+	}
+
+	// Views are managed by the view resource
+	if opts.Type == mongoclient.CollectionTypeView {
+		diags.Append(
+			errs.NewCollectionTypeMismatch(data.Name.ValueString(), opts.Type, mongoclient.CollectionTypeCollection).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	// Read the options of the collection to detect drift
+	diags.Append(readCollectionOptions(opts, data)...)
+
 	return diags
 }
 
+// collectionOptions returns the options of the collection in the resource data.
+func collectionOptions(data *CollectionResourceModel) (*mongoclient.CollectionOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := &mongoclient.CollectionOptions{
+		ValidationLevel:  data.ValidationLevel.ValueString(),
+		ValidationAction: data.ValidationAction.ValueString(),
+		Capped:           data.Capped.ValueBool(),
+		Size:             data.SizeBytes.ValueInt64(),
+		Max:              data.MaxDocuments.ValueInt64(),
+		TimeSeries:       timeseriesOptions(data.Timeseries),
+
+		ExpireAfterSeconds: data.ExpireAfterSeconds.ValueInt64(),
+
+		Collation:      resourcecollation.ToCollation(data.Collation),
+		ClusteredIndex: clusteredIndexOptions(data.ClusteredIndex),
+	}
+	if data.ChangeStreamPreAndPostImages.ValueBool() {
+		opts.ChangeStreamPreAndPostImages = &mongoclient.ChangeStreamPreAndPostImagesOptions{Enabled: true}
+	}
+	if !data.StorageEngine.IsNull() {
+		storageEngine, err := mongoclient.ParseEJSONDocument(data.StorageEngine.ValueString())
+		if err != nil {
+			diags.Append(
+				errs.NewEJsonParseError(err).ToDiagnostic(),
+			)
+			return nil, diags
+		}
+		opts.StorageEngine = storageEngine
+	}
+	if !data.Options.IsNull() {
+		extra, err := mongoclient.ParseEJSONDocument(data.Options.ValueString())
+		if err != nil {
+			diags.Append(
+				errs.NewEJsonParseError(err).ToDiagnostic(),
+			)
+			return nil, diags
+		}
+		opts.Extra = extra
+	}
+	if !data.Validator.IsNull() {
+		validator, err := mongoclient.ParseEJSONDocument(data.Validator.ValueString())
+		if err != nil {
+			diags.Append(
+				errs.NewEJsonParseError(err).ToDiagnostic(),
+			)
+			return nil, diags
+		}
+		opts.Validator = validator
+	}
+
+	return opts, diags
+}
+
+// readCollectionOptions sets the options of the collection read from the server
+// to the resource data. The validator in the data is kept if it is equal to
+// the validator of the collection, so that its formatting is not reported as drift.
+func readCollectionOptions(opts *mongoclient.CollectionOptions, data *CollectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if opts.Validator == nil {
+		data.Validator = basetypes.NewStringNull()
+	} else {
+		validator, err := mongoclient.EJSONString(opts.Validator)
+		if err != nil {
+			diags.Append(
+				errs.NewEJsonParseError(err).ToDiagnostic(),
+			)
+			return diags
+		}
+		if data.Validator.IsNull() || !mongoclient.EqualEJSON(data.Validator.ValueString(), validator) {
+			data.Validator = basetypes.NewStringValue(validator)
+		}
+	}
+
+	// The server omits the validation level and action which have never been set
+	data.ValidationLevel = basetypes.NewStringValue(mongoclient.ValidationLevelStrict)
+	if opts.ValidationLevel != "" {
+		data.ValidationLevel = basetypes.NewStringValue(opts.ValidationLevel)
+	}
+	data.ValidationAction = basetypes.NewStringValue(mongoclient.ValidationActionError)
+	if opts.ValidationAction != "" {
+		data.ValidationAction = basetypes.NewStringValue(opts.ValidationAction)
+	}
+
+	readCapped(opts, data)
+	data.Timeseries = readTimeseries(opts.TimeSeries)
+	data.ExpireAfterSeconds = basetypes.NewInt64Null()
+	if opts.ExpireAfterSeconds > 0 {
+		data.ExpireAfterSeconds = basetypes.NewInt64Value(opts.ExpireAfterSeconds)
+	}
+	data.Collation = resourcecollation.FromCollation(opts.Collation)
+	data.ClusteredIndex = readClusteredIndex(opts.ClusteredIndex)
+	data.ChangeStreamPreAndPostImages = basetypes.NewBoolValue(
+		opts.ChangeStreamPreAndPostImages != nil && opts.ChangeStreamPreAndPostImages.Enabled,
+	)
+	diags.Append(readEJSONOption(opts.StorageEngine, &data.StorageEngine)...)
+	diags.Append(readExtraOptions(opts, data)...)
+
+	return diags
+}
+
+// readCapped sets the size limits of the capped collection read from the server
+// to the resource data. The size in the data is kept if the server has rounded it up.
+func readCapped(opts *mongoclient.CollectionOptions, data *CollectionResourceModel) {
+	size := data.SizeBytes
+	data.Capped = basetypes.NewBoolValue(opts.Capped)
+	data.SizeBytes = basetypes.NewInt64Null()
+	data.MaxDocuments = basetypes.NewInt64Null()
+	if !opts.Capped {
+		return
+	}
+
+	data.SizeBytes = basetypes.NewInt64Value(opts.Size)
+	if !size.IsNull() && !size.IsUnknown() && mongoclient.CappedSize(size.ValueInt64()) == opts.Size {
+		data.SizeBytes = size
+	}
+	if opts.Max > 0 {
+		data.MaxDocuments = basetypes.NewInt64Value(opts.Max)
+	}
+}
+
 func resourceCreate(client *mongoclient.MongoClient, data *CollectionResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -114,9 +289,27 @@ func resourceCreate(client *mongoclient.MongoClient, data *CollectionResourceMod
 		return diags
 	}
 
-	// Create the collection
+	// Create the collection with the options
+	opts, d := collectionOptions(data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
 	collection := database.Collection(name)
-	if err := collection.EnsureExistance(); err != nil {
+	existing, err := collection.Options()
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	if existing != nil && existing.Type == mongoclient.CollectionTypeView {
+		diags.Append(
+			errs.NewCollectionTypeMismatch(name, existing.Type, mongoclient.CollectionTypeCollection).ToDiagnostic(),
+		)
+		return diags
+	}
+	if err := collection.Create(opts); err != nil {
 		diags.Append(
 			errs.NewMongoClientError(err).ToDiagnostic(),
 		)
@@ -132,6 +325,171 @@ func resourceCreate(client *mongoclient.MongoClient, data *CollectionResourceMod
 	return diags
 }
 
+// validateCapped checks the size limits are set only for capped collections.
+func validateCapped(data *CollectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Capped.IsUnknown() || data.SizeBytes.IsUnknown() || data.MaxDocuments.IsUnknown() {
+		return diags
+	}
+
+	if data.Capped.ValueBool() {
+		if data.SizeBytes.IsNull() || data.SizeBytes.ValueInt64() <= 0 {
+			diags.Append(
+				errs.NewInvalidResourceConfiguration("size_bytes must be a positive number for capped collections").ToDiagnostic(),
+			)
+		}
+		if !data.MaxDocuments.IsNull() && data.MaxDocuments.ValueInt64() <= 0 {
+			diags.Append(
+				errs.NewInvalidResourceConfiguration("max_documents must be a positive number").ToDiagnostic(),
+			)
+		}
+		return diags
+	}
+
+	if !data.SizeBytes.IsNull() || !data.MaxDocuments.IsNull() {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("size_bytes and max_documents can be set only for capped collections").ToDiagnostic(),
+		)
+	}
+	return diags
+}
+
+// resizedAttributes returns the paths of the size limits changed in the plan
+// of a collection which stays capped.
+func resizedAttributes(plan *CollectionResourceModel, state *CollectionResourceModel) path.Paths {
+	var resized path.Paths
+	if !plan.Capped.ValueBool() || !state.Capped.ValueBool() {
+		return resized
+	}
+
+	if !plan.SizeBytes.IsUnknown() && !plan.SizeBytes.Equal(state.SizeBytes) {
+		resized = append(resized, path.Root("size_bytes"))
+	}
+	if !plan.MaxDocuments.IsUnknown() && !plan.MaxDocuments.Equal(state.MaxDocuments) {
+		resized = append(resized, path.Root("max_documents"))
+	}
+	return resized
+}
+
+// planResize returns the resized attributes requiring the collection to be replaced,
+// which are all of them if the server cannot resize capped collections.
+func planResize(client *mongoclient.MongoClient, resized path.Paths) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	version, err := client.ServerVersion()
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return nil, diags
+	}
+
+	minVersion := mongoclient.MinServerVersionToResize
+	if version.AtLeast(minVersion.Major, minVersion.Minor) {
+		return nil, diags
+	}
+
+	for _, attribute := range resized {
+		diags.Append(
+			errs.NewReplacementRequired(
+				attribute.String(),
+				fmt.Sprintf(
+					"MongoDB %s cannot resize capped collections, which requires MongoDB %s or later. "+
+						"The collection will be dropped and created again, losing its documents.",
+					version, minVersion.String(),
+				),
+			).ToDiagnostic(),
+		)
+	}
+	return resized, diags
+}
+
+func resourceUpdate(client *mongoclient.MongoClient, data *CollectionResourceModel, state *CollectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Rename the collection before applying the options to it,
+	// which is planned only if rename_in_place is set.
+	// The server creates the target database if it does not exist.
+	if renamed(data, state) {
+		diags.Append(renameCollection(client, data, state)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Check if the database exists
+	database := database.CheckExistance(client, data.Database.ValueString(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Apply the options to the collection in place
+	opts, d := collectionOptions(data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	// Only the changed options are applied, as the options of the time-series
+	// collections and the validation cannot be modified together
+	collection := database.Collection(data.Name.ValueString())
+	if !data.Validator.Equal(state.Validator) || !data.ValidationLevel.Equal(state.ValidationLevel) || !data.ValidationAction.Equal(state.ValidationAction) {
+		if err := collection.Modify(opts); err != nil {
+			diags.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return diags
+		}
+	}
+
+	// Change the bucketing of the time-series collection, which is replaced instead
+	// if the bucketing cannot be changed in place
+	if opts.TimeSeries != nil && state.Timeseries != nil && *opts.TimeSeries != *timeseriesOptions(state.Timeseries) {
+		if err := collection.ModifyTimeSeries(opts.TimeSeries); err != nil {
+			diags.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return diags
+		}
+	}
+	if !data.ExpireAfterSeconds.Equal(state.ExpireAfterSeconds) {
+		if err := collection.SetExpireAfterSeconds(opts.ExpireAfterSeconds); err != nil {
+			diags.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return diags
+		}
+	}
+
+	if !data.ChangeStreamPreAndPostImages.Equal(state.ChangeStreamPreAndPostImages) {
+		if err := collection.SetChangeStreamPreAndPostImages(data.ChangeStreamPreAndPostImages.ValueBool()); err != nil {
+			diags.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return diags
+		}
+	}
+
+	// Resize the capped collection, which is replaced instead
+	// if the server cannot resize it
+	if len(resizedAttributes(data, state)) > 0 {
+		if err := collection.Resize(opts.Size, opts.Max); err != nil {
+			diags.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return diags
+		}
+	}
+
+	// Perform the read operation
+	diags.Append(resourceRead(client, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
 func resourceDelete(client *mongoclient.MongoClient, data *CollectionResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -150,20 +508,28 @@ func resourceDelete(client *mongoclient.MongoClient, data *CollectionResourceMod
 		return diags
 	}
 
-	// Check if the collection exists
+	// Check if the collection exists, with the options which tell views from collections
 	collection := database.Collection(data.Name.ValueString())
-	exists, err = collection.Exists()
+	opts, err := collection.Options()
 	if err != nil {
 		diags.Append(
 			errs.NewMongoClientError(err).ToDiagnostic(),
 		)
 		return diags
 	}
-	if !exists {
+	if opts == nil {
 		// Collection doesn't exist, nothing to delete
 		return diags
 	}
 
+	// Views replacing the collection are managed by the view resource
+	if opts.Type == mongoclient.CollectionTypeView {
+		diags.Append(
+			errs.NewCollectionTypeMismatch(data.Name.ValueString(), opts.Type, mongoclient.CollectionTypeCollection).ToDiagnostic(),
+		)
+		return diags
+	}
+
 	// Check if the collection is empty
 	isEmpty, err := collection.IsEmpty()
 	if err != nil {
@@ -7,12 +7,14 @@ import (
 	"context"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
 	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
 	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/zap"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -32,6 +34,19 @@ type CollectionDataSourceModel struct {
 	Id       types.String `tfsdk:"id"`
 	Database types.String `tfsdk:"database"`
 	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Options  types.String `tfsdk:"options"`
+	ReadOnly types.Bool   `tfsdk:"read_only"`
+	UUID     types.String `tfsdk:"uuid"`
+}
+
+// logFields returns the fields identifying the target of the operations in the logs.
+func (data *CollectionDataSourceModel) logFields() []zap.Field {
+	return []zap.Field{
+		logging.String(logging.KeyDatabase, data.Database),
+		logging.String(logging.KeyCollection, data.Name),
+		logging.String(logging.KeyID, data.Id),
+	}
 }
 
 func (d *CollectionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -66,6 +81,36 @@ func (d *CollectionDataSource) Schema(ctx context.Context, req datasource.Schema
 				MarkdownDescription: "Name of the database",
 				Required:            true,
 			},
+			"type": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Type of the collection, which is one of %s.
+						Views are read-only and are managed by the %s resource.
+					`,
+					mdutils.InlineCodeBlocks(mongoclient.CollectionTypes),
+					mdutils.InlineCodeBlock("mongodb_database_view"),
+				),
+			},
+			"options": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Options of the collection reported by the %s command,
+						which is a stringified EJSON document. Use %s to read the options.
+					`,
+					mdutils.InlineCodeBlock("listCollections"),
+					mdutils.InlineCodeBlock("jsondecode"),
+				),
+			},
+			"read_only": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the collection is read-only, such as a view.",
+			},
+			"uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the collection. Views have no UUID.",
+			},
 		},
 	}
 }
@@ -81,8 +126,19 @@ func (d *CollectionDataSource) Configure(ctx context.Context, req datasource.Con
 }
 
 func (d *CollectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	client := mongoclient.New(ctx, d.config.ClientConfig).WithLogger(d.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data CollectionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := d.config.WithTimeout(ctx, "read", nil, &resp.Diagnostics)
+	defer done()
+
+	d.config.Run(ctx, "read", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -90,13 +146,6 @@ func (d *CollectionDataSource) Read(ctx context.Context, req datasource.ReadRequ
 			return
 		}
 
-		var data CollectionDataSourceModel
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform read operation
 		resp.Diagnostics.Append(dataSourceRead(client, &data)...)
 		if resp.Diagnostics.HasError() {
@@ -46,6 +46,10 @@ func TestAccCollectionDataSource(t *testing.T) {
 					`, server.URI()),
 					Check: resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr("data.mongodb_database_collection.test", "id", "databases/test-database/collections/test-collection"),
+						resource.TestCheckResourceAttr("data.mongodb_database_collection.test", "type", "collection"),
+						resource.TestCheckResourceAttr("data.mongodb_database_collection.test", "options", "{}"),
+						resource.TestCheckResourceAttr("data.mongodb_database_collection.test", "read_only", "false"),
+						resource.TestCheckResourceAttrSet("data.mongodb_database_collection.test", "uuid"),
 					),
 				},
 			},
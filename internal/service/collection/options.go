@@ -0,0 +1,205 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package collection
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ClusteredIndexModel describes the clustered_index block of the collection resource.
+type ClusteredIndexModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func clusteredIndexBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: mdutils.FormatSchemaDescription(
+			`
+				Makes the collection a [clustered collection](https://www.mongodb.com/docs/manual/core/clustered-collections/),
+				which stores its documents in the order of their %s.
+				The documents of a clustered collection can expire with %s.
+
+				Adding or removing this block replaces the collection.
+			`,
+			mdutils.InlineCodeBlock("_id"),
+			mdutils.InlineCodeBlock("expire_after_seconds"),
+		),
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the clustered index, which is named by the server if it is not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func clusteredIndexOptions(data *ClusteredIndexModel) *mongoclient.ClusteredIndexOptions {
+	if data == nil {
+		return nil
+	}
+	return &mongoclient.ClusteredIndexOptions{Name: data.Name.ValueString()}
+}
+
+func readClusteredIndex(opts *mongoclient.ClusteredIndexOptions) *ClusteredIndexModel {
+	if opts == nil {
+		return nil
+	}
+	return &ClusteredIndexModel{Name: basetypes.NewStringValue(opts.Name)}
+}
+
+// readEJSONOption sets the option read from the server to the attribute.
+// The value in the attribute is kept if it is equal to the option,
+// so that its formatting is not reported as drift.
+func readEJSONOption(option []byte, attribute *basetypes.StringValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(option) == 0 {
+		*attribute = basetypes.NewStringNull()
+		return diags
+	}
+
+	value, err := mongoclient.EJSONString(option)
+	if err != nil {
+		diags.Append(
+			errs.NewEJsonParseError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	if attribute.IsNull() || !mongoclient.EqualEJSON(attribute.ValueString(), value) {
+		*attribute = basetypes.NewStringValue(value)
+	}
+	return diags
+}
+
+// readExtraOptions sets the options named in the options attribute to the attribute,
+// so that only the changes of the configured options are reported as drift.
+func readExtraOptions(opts *mongoclient.CollectionOptions, data *CollectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Options.IsNull() || data.Options.IsUnknown() {
+		return diags
+	}
+
+	configured, err := mongoclient.ParseEJSONDocument(data.Options.ValueString())
+	if err != nil {
+		diags.Append(
+			errs.NewEJsonParseError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	elements, err := configured.Elements()
+	if err != nil {
+		diags.Append(
+			errs.NewEJsonParseError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	keys := make([]string, 0, len(elements))
+	for _, element := range elements {
+		keys = append(keys, element.Key())
+	}
+
+	selected, err := mongoclient.SelectFields(opts.Raw, keys)
+	if err != nil {
+		diags.Append(
+			errs.NewEJsonParseError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	diags.Append(readEJSONOption(selected, &data.Options)...)
+	return diags
+}
+
+// validateOptions checks the options attribute leaves the options
+// with their own attributes to the attributes.
+func validateOptions(data *CollectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Options.IsNull() || data.Options.IsUnknown() {
+		return diags
+	}
+
+	options, err := mongoclient.ParseEJSONDocument(data.Options.ValueString())
+	if err != nil {
+		// The attribute validator reports the invalid documents
+		return diags
+	}
+	elements, err := options.Elements()
+	if err != nil {
+		return diags
+	}
+
+	var typed []string
+	for _, element := range elements {
+		if slices.Contains(mongoclient.TypedCollectionOptions, element.Key()) {
+			typed = append(typed, element.Key())
+		}
+	}
+	if len(typed) > 0 {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration(
+				fmt.Sprintf("options cannot contain %s, which must be set with their own attributes", strings.Join(typed, ", ")),
+			).ToDiagnostic(),
+		)
+	}
+	return diags
+}
+
+// replacedOptions returns the paths of the options changed in the plan
+// which can only be changed by replacing the collection.
+func replacedOptions(plan *CollectionResourceModel, state *CollectionResourceModel) path.Paths {
+	var replaced path.Paths
+
+	if (plan.Collation == nil) != (state.Collation == nil) ||
+		(plan.Collation != nil && !plan.Collation.Locale.Equal(state.Collation.Locale)) {
+		replaced = append(replaced, path.Root("collation"))
+	}
+	if (plan.ClusteredIndex == nil) != (state.ClusteredIndex == nil) {
+		replaced = append(replaced, path.Root("clustered_index"))
+	}
+	if !plan.StorageEngine.IsUnknown() && !plan.StorageEngine.Equal(state.StorageEngine) {
+		replaced = append(replaced, path.Root("storage_engine"))
+	}
+	if !plan.Options.IsUnknown() && !plan.Options.Equal(state.Options) {
+		replaced = append(replaced, path.Root("options"))
+	}
+	return replaced
+}
+
+// replacementWarnings warns the collection is replaced to change the options.
+func replacementWarnings(replaced path.Paths) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, attribute := range replaced {
+		diags.Append(
+			errs.NewReplacementRequired(
+				attribute.String(),
+				"The option cannot be changed on an existing collection. "+
+					"The collection will be dropped and created again, losing its documents.",
+			).ToDiagnostic(),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package collection
+
+import (
+	"context"
+	"sync"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// requiresReplaceUnlessRenamedInPlace replaces the collection when the attribute changes,
+// unless rename_in_place is set in the plan.
+func requiresReplaceUnlessRenamedInPlace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			var renameInPlace types.Bool
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rename_in_place"), &renameInPlace)...)
+			resp.RequiresReplace = !renameInPlace.ValueBool()
+		},
+		"Replaces the collection unless rename_in_place is set.",
+		"Replaces the collection unless `rename_in_place` is set.",
+	)
+}
+
+// renamesInPlace holds the namespaces of the collections planned to be renamed in place
+// by the namespaces they are renamed to. The plan modifiers of the documents and the indexes
+// cannot reach the provider data, so the plans of the collections are shared through it.
+// The collections are planned before the resources referring to them, both in the plan
+// and again right before they are applied.
+var renamesInPlace sync.Map
+
+// namespace returns the full name of the collection in the database.
+func namespace(database string, name string) string {
+	return database + "." + name
+}
+
+// planRenameInPlace records the collection in the state is planned
+// to be renamed in place to the name and the database in the plan.
+func planRenameInPlace(plan *CollectionResourceModel, state *CollectionResourceModel) {
+	if !plan.RenameInPlace.ValueBool() || !renamed(plan, state) {
+		return
+	}
+	if plan.Database.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	renamesInPlace.Store(
+		namespace(plan.Database.ValueString(), plan.Name.ValueString()),
+		namespace(state.Database.ValueString(), state.Name.ValueString()),
+	)
+}
+
+// RequiresReplaceUnlessFollowed replaces the resource in a collection when the database
+// or the collection attribute changes, unless the collection is renamed in place,
+// in which case the resource follows the collection.
+func RequiresReplaceUnlessFollowed() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			var planDatabase, planCollection, stateDatabase, stateCollection types.String
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("database"), &planDatabase)...)
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("collection"), &planCollection)...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("database"), &stateDatabase)...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("collection"), &stateCollection)...)
+			if resp.Diagnostics.HasError() || planDatabase.IsUnknown() || planCollection.IsUnknown() {
+				resp.RequiresReplace = true
+				return
+			}
+
+			previous, ok := renamesInPlace.Load(namespace(planDatabase.ValueString(), planCollection.ValueString()))
+			resp.RequiresReplace = !ok || previous != namespace(stateDatabase.ValueString(), stateCollection.ValueString())
+		},
+		"Replaces the resource unless its collection is renamed in place.",
+		"Replaces the resource unless its collection is renamed in place with `rename_in_place`.",
+	)
+}
+
+// renamed reports whether the plan renames the collection or moves it into another database.
+func renamed(plan *CollectionResourceModel, state *CollectionResourceModel) bool {
+	return !plan.Name.Equal(state.Name) || !plan.Database.Equal(state.Database)
+}
+
+// renameCollection renames the collection in the state to the name and the database in the plan.
+func renameCollection(client *mongoclient.MongoClient, data *CollectionResourceModel, state *CollectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Validate collection name
+	name := data.Name.ValueString()
+	if name == mongoclient.PlaceholderCollectionName {
+		diags.Append(
+			errs.NewInvalidCollectionName(name).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	collection := client.Database(state.Database.ValueString()).Collection(state.Name.ValueString())
+	if err := collection.Rename(data.Database.ValueString(), name); err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	return diags
+}
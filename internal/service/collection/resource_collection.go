@@ -5,26 +5,35 @@ package collection
 
 import (
 	"context"
+	"strconv"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	resourcecollation "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/collation"
 	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
 	resourceid "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/id"
 	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/zap"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CollectionResource{}
 var _ resource.ResourceWithImportState = &CollectionResource{}
+var _ resource.ResourceWithValidateConfig = &CollectionResource{}
+var _ resource.ResourceWithModifyPlan = &CollectionResource{}
 
 func NewCollectionResource() resource.Resource {
 	return &CollectionResource{}
@@ -37,10 +46,38 @@ type CollectionResource struct {
 
 // CollectionResourceModel describes the resource data model.
 type CollectionResourceModel struct {
-	Id           types.String `tfsdk:"id"`
-	Database     types.String `tfsdk:"database"`
-	Name         types.String `tfsdk:"name"`
-	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
+	Id            types.String   `tfsdk:"id"`
+	Database      types.String   `tfsdk:"database"`
+	Name          types.String   `tfsdk:"name"`
+	ForceDestroy  types.Bool     `tfsdk:"force_destroy"`
+	RenameInPlace types.Bool     `tfsdk:"rename_in_place"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+
+	Validator        types.String `tfsdk:"validator"`
+	ValidationLevel  types.String `tfsdk:"validation_level"`
+	ValidationAction types.String `tfsdk:"validation_action"`
+
+	Capped       types.Bool  `tfsdk:"capped"`
+	SizeBytes    types.Int64 `tfsdk:"size_bytes"`
+	MaxDocuments types.Int64 `tfsdk:"max_documents"`
+
+	Timeseries         *TimeseriesModel `tfsdk:"timeseries"`
+	ExpireAfterSeconds types.Int64      `tfsdk:"expire_after_seconds"`
+
+	Collation                    *resourcecollation.Model `tfsdk:"collation"`
+	ClusteredIndex               *ClusteredIndexModel     `tfsdk:"clustered_index"`
+	ChangeStreamPreAndPostImages types.Bool               `tfsdk:"change_stream_pre_and_post_images"`
+	StorageEngine                types.String             `tfsdk:"storage_engine"`
+	Options                      types.String             `tfsdk:"options"`
+}
+
+// logFields returns the fields identifying the target of the operations in the logs.
+func (data *CollectionResourceModel) logFields() []zap.Field {
+	return []zap.Field{
+		logging.String(logging.KeyDatabase, data.Database),
+		logging.String(logging.KeyCollection, data.Name),
+		logging.String(logging.KeyID, data.Id),
+	}
 }
 
 func (r *CollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -77,17 +114,33 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"database": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Name of the database to create the collection in.",
+				Required: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Name of the database to create the collection in.
+
+						Changing this attribute replaces the collection,
+						unless %s is set to move the collection into the other database.
+					`,
+					mdutils.InlineCodeBlock("rename_in_place"),
+				),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessRenamedInPlace(),
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the collection",
-				Required:            true,
+				Required: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Name of the collection.
+
+						Changing this attribute replaces the collection,
+						unless %s is set to rename the collection.
+					`,
+					mdutils.InlineCodeBlock("rename_in_place"),
+				),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessRenamedInPlace(),
 				},
 			},
 			"force_destroy": schema.BoolAttribute{
@@ -106,10 +159,282 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rename_in_place": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Whether to rename the collection in place with the %s command
+						when %s or %s changes, instead of replacing the collection.
+
+						The collection keeps its documents and indexes when it is renamed,
+						and the index and document resources in the collection follow it.
+						Moving the collection into another database copies its documents,
+						which takes a while for large collections.
+						Defaults to %s.
+					`,
+					mdutils.InlineCodeBlock("renameCollection"),
+					mdutils.InlineCodeBlock("name"),
+					mdutils.InlineCodeBlock("database"),
+					mdutils.InlineCodeBlock("false"),
+				),
+			},
+			"validator": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						[Validator](https://www.mongodb.com/docs/manual/core/schema-validation/)
+						of the documents in the collection, which is usually a %s expression.
+
+						The value of this attribute is a stringified
+						[EJSON](https://www.mongodb.com/docs/manual/reference/mongodb-extended-json/#std-label-mongodb-extended-json-v2)
+						document, which you can write with the %s function:
+
+						%s
+
+						Changes are applied to the existing collection with the %s command.
+					`,
+					mdutils.InlineCodeBlock("$jsonSchema"),
+					mdutils.InlineCodeBlock("jsonencode"),
+					mdutils.CodeBlock("terraform", "validator = jsonencode({ \"$jsonSchema\" = { bsonType = \"object\", required = [\"name\"] } })"),
+					mdutils.InlineCodeBlock("collMod"),
+				),
+				Validators: []validator.String{IsEJSONDocument()},
+			},
+			"validation_level": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(mongoclient.ValidationLevelStrict),
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						How strictly the validator is applied to the inserted and updated documents,
+						which is one of %s. Defaults to %s.
+					`,
+					mdutils.InlineCodeBlocks(mongoclient.ValidationLevels),
+					mdutils.InlineCodeBlock(mongoclient.ValidationLevelStrict),
+				),
+				Validators: []validator.String{IsValidationLevel()},
+			},
+			"validation_action": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(mongoclient.ValidationActionError),
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Whether to reject the invalid documents or only to log warnings about them,
+						which is one of %s. Defaults to %s.
+					`,
+					mdutils.InlineCodeBlocks(mongoclient.ValidationActions),
+					mdutils.InlineCodeBlock(mongoclient.ValidationActionError),
+				),
+				Validators: []validator.String{IsValidationAction()},
+			},
+			"capped": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Whether the collection is a
+						[capped collection](https://www.mongodb.com/docs/manual/core/capped-collections/),
+						which removes its oldest documents once it reaches its size limit.
+						%s is required for capped collections.
+
+						Changing this attribute replaces the collection.
+					`,
+					mdutils.InlineCodeBlock("size_bytes"),
+				),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"size_bytes": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Maximum size of the capped collection in bytes,
+						which the server rounds up to a multiple of %s.
+
+						The collection is resized in place with the %s command on MongoDB %s or later,
+						and replaced on the earlier versions.
+					`,
+					mdutils.InlineCodeBlock(strconv.Itoa(mongoclient.CappedSizeUnit)),
+					mdutils.InlineCodeBlock("collMod"),
+					mongoclient.MinServerVersionToResize.String(),
+				),
+			},
+			"max_documents": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Maximum number of documents in the capped collection.
+						The size limit takes precedence over this limit.
+
+						The limit is changed in place with the %s command on MongoDB %s or later,
+						and the collection is replaced on the earlier versions.
+					`,
+					mdutils.InlineCodeBlock("collMod"),
+					mongoclient.MinServerVersionToResize.String(),
+				),
+			},
+			"expire_after_seconds": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Number of seconds after which the documents of the time-series
+						or the clustered collection are removed.
+						Changes are applied to the existing collection with the %s command.
+					`,
+					mdutils.InlineCodeBlock("collMod"),
+				),
+			},
+			"change_stream_pre_and_post_images": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Whether the [change streams](https://www.mongodb.com/docs/manual/changeStreams/)
+						on the collection report the documents before and after the changes.
+						Changes are applied to the existing collection with the %s command.
+						Defaults to %s.
+					`,
+					mdutils.InlineCodeBlock("collMod"),
+					mdutils.InlineCodeBlock("false"),
+				),
+			},
+			"storage_engine": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Configuration of the storage engine for the collection,
+						which is a stringified EJSON document such as the following:
+
+						%s
+
+						Changing this attribute replaces the collection.
+					`,
+					mdutils.CodeBlock("terraform", "storage_engine = jsonencode({ wiredTiger = { configString = \"block_compressor=zstd\" } })"),
+				),
+				Validators: []validator.String{IsEJSONDocument()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"options": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Other options of the %s command without their own attributes,
+						which is a stringified EJSON document passed to the command as it is,
+						such as the following:
+
+						%s
+
+						Only the options in this document are compared with the options of the collection.
+						Changing this attribute replaces the collection.
+					`,
+					mdutils.InlineCodeBlock("create"),
+					mdutils.CodeBlock("terraform", "options = jsonencode({ indexOptionDefaults = { storageEngine = { wiredTiger = {} } } })"),
+				),
+				Validators: []validator.String{IsEJSONDocument()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeseries":      timeseriesBlock(),
+			"clustered_index": clusteredIndexBlock(),
+			"collation": resourcecollation.ResourceBlock(mdutils.FormatSchemaDescription(
+				`
+					Default [collation](https://www.mongodb.com/docs/manual/reference/collation/)
+					of the collection, which its indexes and queries use unless they set their own.
+
+					Changing the collation replaces the collection.
+				`,
+			)),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+func (r *CollectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CollectionResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCapped(&data)...)
+	resp.Diagnostics.Append(validateTimeseries(&data)...)
+	resp.Diagnostics.Append(validateOptions(&data)...)
+}
+
+func (r *CollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on creation and destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state CollectionResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The identifier of the renamed collection is known after the rename,
+	// and the documents and the indexes in it follow the collection renamed in place
+	if renamed(&plan, &state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+		planRenameInPlace(&plan, &state)
+	}
+
+	// Warn the collection is replaced to change the options
+	resp.Diagnostics.Append(replacementWarnings(replacedOptions(&plan, &state))...)
+
+	// Plan the granularity kept by the server
+	if attribute, granularity, ok := planGranularity(plan.Timeseries, state.Timeseries); ok {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attribute, granularity)...)
+	}
+
+	// The server is asked whether it can resize the collection only if it is resized
+	resized := resizedAttributes(&plan, &state)
+	if len(resized) == 0 || r.config == nil {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "plan", nil, &resp.Diagnostics)
+	defer done()
+
+	r.config.Run(ctx, "plan", plan.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
+		if err != nil {
+			resp.Diagnostics.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return
+		}
+
+		// Replace the collection if the server cannot resize it
+		requiresReplace, diags := planResize(client, resized)
+		resp.Diagnostics.Append(diags...)
+		resp.RequiresReplace.Append(requiresReplace...)
+	})
+}
+
 func (r *CollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	config, diags := resourceconfig.FromProviderData(req.ProviderData)
 	resp.Diagnostics.Append(diags...)
@@ -121,8 +446,22 @@ func (r *CollectionResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data CollectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "create", data.Timeouts.Create, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "create", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -130,14 +469,6 @@ func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequ
 			return
 		}
 
-		var data CollectionResourceModel
-
-		// Read Terraform plan data into the model
-		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform create operation
 		resp.Diagnostics.Append(resourceCreate(client, &data)...)
 		if resp.Diagnostics.HasError() {
@@ -150,8 +481,22 @@ func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data CollectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "read", data.Timeouts.Read, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "read", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -159,14 +504,6 @@ func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest,
 			return
 		}
 
-		var data CollectionResourceModel
-
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform read operation
 		resp.Diagnostics.Append(resourceRead(client, &data)...)
 		if resp.Diagnostics.HasError() {
@@ -179,22 +516,23 @@ func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data CollectionResourceModel
+	var data, state CollectionResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "update", data.Timeouts.Update, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	r.config.Run(ctx, "update", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -202,13 +540,41 @@ func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 			return
 		}
 
-		var data CollectionResourceModel
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		// Perform update operation
+		resp.Diagnostics.Append(resourceUpdate(client, &data, &state)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	})
+}
+
+func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CollectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "delete", data.Timeouts.Delete, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "delete", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
+		if err != nil {
+			resp.Diagnostics.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return
+		}
+
 		// Perform delete operation
 		resp.Diagnostics.Append(resourceDelete(client, &data)...)
 	})
@@ -4,6 +4,7 @@
 package collection_test
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -13,6 +14,8 @@ import (
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/acc"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/mongolocal"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccCollectionResource_Lifecycle(t *testing.T) {
@@ -146,3 +149,577 @@ func TestAccCollectionResource_ForceDestroy(t *testing.T) {
 		})
 	})
 }
+
+func TestAccCollectionResource_Validator(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		mongoclient.FromURI(server.URI()).Run(func(client *mongoclient.MongoClient, err error) {
+			if err != nil {
+				logger.Sugar().Fatalf("failed to create a client: %v", err)
+			}
+
+			logger.Info("creating a database to test the validator of the collection resource")
+			database := client.Database("test-database")
+			if err := database.Collection(mongoclient.PlaceholderCollectionName).EnsureExistance(); err != nil {
+				logger.Sugar().Fatalf("failed to create a collection: %v", err)
+			}
+		})
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Create with a validator
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+							validator = jsonencode({
+								"$jsonSchema" = {
+									bsonType = "object"
+									required = ["name"]
+								}
+							})
+							validation_action = "warn"
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "validator", `{"$jsonSchema":{"bsonType":"object","required":["name"]}}`),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "validation_level", "strict"),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "validation_action", "warn"),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:            "mongodb_database_collection.test",
+					ImportStateId:           "databases/test-database/collections/test-collection",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy"},
+				},
+				// Update the validator in place
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+							validator = jsonencode({
+								"$jsonSchema" = {
+									bsonType = "object"
+									required = ["name", "age"]
+								}
+							})
+							validation_level = "moderate"
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_collection.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "validator", `{"$jsonSchema":{"bsonType":"object","required":["name","age"]}}`),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "validation_level", "moderate"),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "validation_action", "error"),
+					),
+				},
+				// Detect the validator removed outside of Terraform
+				{
+					PreConfig: func() {
+						mongoclient.FromURI(server.URI()).Run(func(client *mongoclient.MongoClient, err error) {
+							if err != nil {
+								t.Fatalf("failed to create a client: %v", err)
+							}
+							collection := client.Database("test-database").Collection("test-collection")
+							if err := collection.Modify(&mongoclient.CollectionOptions{}); err != nil {
+								t.Fatalf("failed to remove the validator: %v", err)
+							}
+						})
+					},
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+							validator = jsonencode({
+								"$jsonSchema" = {
+									bsonType = "object"
+									required = ["name", "age"]
+								}
+							})
+							validation_level = "moderate"
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_collection.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "validator", `{"$jsonSchema":{"bsonType":"object","required":["name","age"]}}`),
+					),
+				},
+				// Remove the validator
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr("mongodb_database_collection.test", "validator"),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "validation_level", "strict"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccCollectionResource_Capped(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		mongoclient.FromURI(server.URI()).Run(func(client *mongoclient.MongoClient, err error) {
+			if err != nil {
+				logger.Sugar().Fatalf("failed to create a client: %v", err)
+			}
+
+			logger.Info("creating a database to test the capped collections")
+			database := client.Database("test-database")
+			if err := database.Collection(mongoclient.PlaceholderCollectionName).EnsureExistance(); err != nil {
+				logger.Sugar().Fatalf("failed to create a collection: %v", err)
+			}
+		})
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// The size is required for capped collections
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+							capped = true
+						}
+					`, server.URI()),
+					ExpectError: regexp.MustCompile(errs.NewInvalidResourceConfiguration("").Name()),
+				},
+				// Create a capped collection, whose size is rounded up by the server
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+							capped = true
+							size_bytes = 100000
+							max_documents = 100
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "capped", "true"),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "size_bytes", "100000"),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "max_documents", "100"),
+					),
+				},
+				// ImportState testing reports the size of the server
+				{
+					ResourceName:      "mongodb_database_collection.test",
+					ImportStateId:     "databases/test-database/collections/test-collection",
+					ImportState:       true,
+					ImportStateVerify: true,
+					ImportStateCheck: func(states []*terraform.InstanceState) error {
+						if size := states[0].Attributes["size_bytes"]; size != "100096" {
+							return fmt.Errorf("expected the size of the server, got %s", size)
+						}
+						return nil
+					},
+					ImportStateVerifyIgnore: []string{"force_destroy", "size_bytes"},
+				},
+				// Resize the collection in place
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+							capped = true
+							size_bytes = 204800
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_collection.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "size_bytes", "204800"),
+						resource.TestCheckNoResourceAttr("mongodb_database_collection.test", "max_documents"),
+					),
+				},
+				// Uncap the collection by replacing it
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_collection.test", plancheck.ResourceActionReplace),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "capped", "false"),
+						resource.TestCheckNoResourceAttr("mongodb_database_collection.test", "size_bytes"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccCollectionResource_Timeseries(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		mongoclient.FromURI(server.URI()).Run(func(client *mongoclient.MongoClient, err error) {
+			if err != nil {
+				logger.Sugar().Fatalf("failed to create a client: %v", err)
+			}
+
+			logger.Info("creating a database to test the time-series collections")
+			database := client.Database("test-database")
+			if err := database.Collection(mongoclient.PlaceholderCollectionName).EnsureExistance(); err != nil {
+				logger.Sugar().Fatalf("failed to create a collection: %v", err)
+			}
+		})
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Create a time-series collection with the default granularity
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+							expire_after_seconds = 3600
+
+							timeseries {
+								time_field = "timestamp"
+								meta_field = "sensor"
+							}
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "timeseries.time_field", "timestamp"),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "timeseries.meta_field", "sensor"),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "timeseries.granularity", "seconds"),
+						resource.TestCheckNoResourceAttr("mongodb_database_collection.test", "timeseries.bucket_max_span_seconds"),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "expire_after_seconds", "3600"),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:            "mongodb_database_collection.test",
+					ImportStateId:           "databases/test-database/collections/test-collection",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy"},
+				},
+				// Coarsen the granularity and change the expiration in place
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+							expire_after_seconds = 7200
+
+							timeseries {
+								time_field = "timestamp"
+								meta_field = "sensor"
+								granularity = "minutes"
+							}
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_collection.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "timeseries.granularity", "minutes"),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "expire_after_seconds", "7200"),
+					),
+				},
+				// Refine the granularity by replacing the collection
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+
+							timeseries {
+								time_field = "timestamp"
+								meta_field = "sensor"
+								granularity = "seconds"
+							}
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_collection.test", plancheck.ResourceActionReplace),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "timeseries.granularity", "seconds"),
+						resource.TestCheckNoResourceAttr("mongodb_database_collection.test", "expire_after_seconds"),
+					),
+				},
+				// Remove the time-series options by replacing the collection
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_collection.test", plancheck.ResourceActionReplace),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr("mongodb_database_collection.test", "timeseries.time_field"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccCollectionResource_RenameInPlace(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		mongoclient.FromURI(server.URI()).Run(func(client *mongoclient.MongoClient, err error) {
+			if err != nil {
+				logger.Sugar().Fatalf("failed to create a client: %v", err)
+			}
+
+			logger.Info("creating databases to test renaming the collection")
+			for _, name := range []string{"test-database", "test-other-database"} {
+				if err := client.Database(name).Collection(mongoclient.PlaceholderCollectionName).EnsureExistance(); err != nil {
+					logger.Sugar().Fatalf("failed to create a collection: %v", err)
+				}
+			}
+		})
+
+		config := func(database string, name string, inPlace bool) string {
+			return acc.WithProviderConfig(fmt.Sprintf(`
+				resource "mongodb_database_collection" "test" {
+					database = "%s"
+					name = "%s"
+					force_destroy = true
+					rename_in_place = %t
+				}
+
+				resource "mongodb_database_index" "test" {
+					database = mongodb_database_collection.test.database
+					collection = mongodb_database_collection.test.name
+					field = "name"
+					force_destroy = true
+				}
+
+				resource "mongodb_database_document" "test" {
+					database = mongodb_database_collection.test.database
+					collection = mongodb_database_collection.test.name
+					document = jsonencode({ name = "test" })
+				}
+			`, database, name, inPlace), server.URI())
+		}
+		expectUpdate := resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				plancheck.ExpectResourceAction("mongodb_database_collection.test", plancheck.ResourceActionUpdate),
+				plancheck.ExpectResourceAction("mongodb_database_index.test", plancheck.ResourceActionUpdate),
+				plancheck.ExpectResourceAction("mongodb_database_document.test", plancheck.ResourceActionUpdate),
+			},
+		}
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Create the collection with an index and a document
+				{
+					Config: config("test-database", "test-collection", true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "id", "databases/test-database/collections/test-collection"),
+					),
+				},
+				// Rename the collection in place
+				{
+					Config:           config("test-database", "test-renamed-collection", true),
+					ConfigPlanChecks: expectUpdate,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "id", "databases/test-database/collections/test-renamed-collection"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "collection", "test-renamed-collection"),
+						resource.TestCheckResourceAttr("mongodb_database_document.test", "collection", "test-renamed-collection"),
+					),
+				},
+				// Move the collection into another database
+				{
+					Config:           config("test-other-database", "test-renamed-collection", true),
+					ConfigPlanChecks: expectUpdate,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "id", "databases/test-other-database/collections/test-renamed-collection"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "database", "test-other-database"),
+						resource.TestCheckResourceAttr("mongodb_database_document.test", "database", "test-other-database"),
+					),
+				},
+				// Renaming the collection without rename_in_place replaces it
+				// together with its index and its document
+				{
+					Config: config("test-other-database", "test-replaced-collection", false),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_collection.test", plancheck.ResourceActionDestroyBeforeCreate),
+							plancheck.ExpectResourceAction("mongodb_database_index.test", plancheck.ResourceActionDestroyBeforeCreate),
+							plancheck.ExpectResourceAction("mongodb_database_document.test", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "id", "databases/test-other-database/collections/test-replaced-collection"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "collection", "test-replaced-collection"),
+						resource.TestCheckResourceAttr("mongodb_database_document.test", "collection", "test-replaced-collection"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccCollectionResource_Options(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		mongoclient.FromURI(server.URI()).Run(func(client *mongoclient.MongoClient, err error) {
+			if err != nil {
+				logger.Sugar().Fatalf("failed to create a client: %v", err)
+			}
+
+			logger.Info("creating a database to test the collection options")
+			database := client.Database("test-database")
+			if err := database.Collection(mongoclient.PlaceholderCollectionName).EnsureExistance(); err != nil {
+				logger.Sugar().Fatalf("failed to create a collection: %v", err)
+			}
+		})
+
+		config := func(locale string, images bool) string {
+			return acc.WithProviderConfig(fmt.Sprintf(`
+				resource "mongodb_database_collection" "test" {
+					database = "test-database"
+					name = "test-collection"
+					expire_after_seconds = 3600
+					change_stream_pre_and_post_images = %t
+					storage_engine = jsonencode({ wiredTiger = { configString = "block_compressor=zstd" } })
+					options = jsonencode({ indexOptionDefaults = { storageEngine = { wiredTiger = {} } } })
+
+					clustered_index {
+						name = "test-clustered-index"
+					}
+
+					collation {
+						locale = "%s"
+					}
+				}
+			`, images, locale), server.URI())
+		}
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Create and Read testing
+				{
+					Config: config("en", false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "clustered_index.name", "test-clustered-index"),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "collation.locale", "en"),
+						resource.TestCheckResourceAttrSet("mongodb_database_collection.test", "collation.strength"),
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "change_stream_pre_and_post_images", "false"),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:            "mongodb_database_collection.test",
+					ImportStateId:           "databases/test-database/collections/test-collection",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy", "rename_in_place", "expire_after_seconds", "options"},
+				},
+				// Enable the change stream images in place
+				{
+					Config: config("en", true),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_collection.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "change_stream_pre_and_post_images", "true"),
+					),
+				},
+				// Change the collation, which replaces the collection
+				{
+					Config: config("fr", true),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_collection.test", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_collection.test", "collation.locale", "fr"),
+					),
+				},
+				// Options with their own attributes are rejected
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-collection"
+							options = jsonencode({ capped = true })
+						}
+					`, server.URI()),
+					ExpectError: regexp.MustCompile(errs.NewInvalidResourceConfiguration("").Name()),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package collection
+
+import (
+	"context"
+	"slices"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// TimeseriesModel describes the timeseries block of the collection resource.
+type TimeseriesModel struct {
+	TimeField             types.String `tfsdk:"time_field"`
+	MetaField             types.String `tfsdk:"meta_field"`
+	Granularity           types.String `tfsdk:"granularity"`
+	BucketMaxSpanSeconds  types.Int64  `tfsdk:"bucket_max_span_seconds"`
+	BucketRoundingSeconds types.Int64  `tfsdk:"bucket_rounding_seconds"`
+}
+
+func timeseriesBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: mdutils.FormatSchemaDescription(
+			`
+				Makes the collection a [time-series collection](https://www.mongodb.com/docs/manual/core/timeseries-collections/).
+
+				The documents are bucketed either by %s or by %s and %s.
+				Coarser granularities and longer bucket spans are applied in place with the %s command,
+				while the other changes, including adding or removing this block, replace the collection.
+			`,
+			mdutils.InlineCodeBlock("granularity"),
+			mdutils.InlineCodeBlock("bucket_max_span_seconds"),
+			mdutils.InlineCodeBlock("bucket_rounding_seconds"),
+			mdutils.InlineCodeBlock("collMod"),
+		),
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplaceIf(
+				requiresReplaceIfTimeseriesToggled,
+				"Adding or removing the time-series options replaces the collection.",
+				"Adding or removing the time-series options replaces the collection.",
+			),
+		},
+		Attributes: map[string]schema.Attribute{
+			"time_field": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the field holding the date of each document.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"meta_field": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the field holding the metadata identifying the series of each document.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"granularity": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Interval between the documents of a series, which is one of %s.
+						Defaults to %s unless the bucket span is set.
+						Changing it to a finer granularity replaces the collection.
+					`,
+					mdutils.InlineCodeBlocks(mongoclient.Granularities),
+					mdutils.InlineCodeBlock(mongoclient.GranularitySeconds),
+				),
+				Validators: []validator.String{IsGranularity()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfFinerGranularity,
+						"Changing the granularity to a finer one replaces the collection.",
+						"Changing the granularity to a finer one replaces the collection.",
+					),
+				},
+			},
+			"bucket_max_span_seconds": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Maximum span of the dates in a bucket in seconds, which must be equal to %s.
+						Requires MongoDB 6.3 or later. Decreasing it replaces the collection.
+					`,
+					mdutils.InlineCodeBlock("bucket_rounding_seconds"),
+				),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						requiresReplaceIfDecreased,
+						"Decreasing the bucket span replaces the collection.",
+						"Decreasing the bucket span replaces the collection.",
+					),
+				},
+			},
+			"bucket_rounding_seconds": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Interval in seconds the start dates of the buckets are rounded down to,
+						which must be equal to %s. Requires MongoDB 6.3 or later.
+						Decreasing it replaces the collection.
+					`,
+					mdutils.InlineCodeBlock("bucket_max_span_seconds"),
+				),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						requiresReplaceIfDecreased,
+						"Decreasing the bucket rounding replaces the collection.",
+						"Decreasing the bucket rounding replaces the collection.",
+					),
+				},
+			},
+		},
+	}
+}
+
+// requiresReplaceIfTimeseriesToggled replaces the collection when the time-series options
+// are added or removed, while the changes of the bucketing are left to the attributes.
+func requiresReplaceIfTimeseriesToggled(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = req.PlanValue.IsNull() != req.StateValue.IsNull()
+}
+
+func requiresReplaceIfFinerGranularity(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() || req.StateValue.IsNull() {
+		return
+	}
+
+	planned := slices.Index(mongoclient.Granularities, req.PlanValue.ValueString())
+	current := slices.Index(mongoclient.Granularities, req.StateValue.ValueString())
+	resp.RequiresReplace = planned < current
+}
+
+func requiresReplaceIfDecreased(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	if req.PlanValue.IsUnknown() || req.StateValue.IsNull() {
+		return
+	}
+
+	resp.RequiresReplace = req.PlanValue.IsNull() || req.PlanValue.ValueInt64() < req.StateValue.ValueInt64()
+}
+
+// planGranularity plans the granularity which is not configured. The server keeps
+// the granularity unless the bucket span is configured, in which case it has none.
+func planGranularity(plan *TimeseriesModel, state *TimeseriesModel) (path.Path, basetypes.StringValue, bool) {
+	if plan == nil || !plan.Granularity.IsUnknown() || plan.BucketMaxSpanSeconds.IsUnknown() {
+		return path.Empty(), basetypes.StringValue{}, false
+	}
+
+	granularity := basetypes.NewStringNull()
+	if plan.BucketMaxSpanSeconds.IsNull() && state != nil {
+		granularity = state.Granularity
+	}
+	return path.Root("timeseries").AtName("granularity"), granularity, true
+}
+
+// timeseriesOptions returns the options of the time-series collection in the block.
+func timeseriesOptions(data *TimeseriesModel) *mongoclient.TimeSeriesOptions {
+	if data == nil {
+		return nil
+	}
+
+	return &mongoclient.TimeSeriesOptions{
+		TimeField:             data.TimeField.ValueString(),
+		MetaField:             data.MetaField.ValueString(),
+		Granularity:           data.Granularity.ValueString(),
+		BucketMaxSpanSeconds:  data.BucketMaxSpanSeconds.ValueInt64(),
+		BucketRoundingSeconds: data.BucketRoundingSeconds.ValueInt64(),
+	}
+}
+
+// readTimeseries returns the block of the time-series collection options.
+// The bucket span and rounding which the server derives
+// from the granularity are left out.
+func readTimeseries(opts *mongoclient.TimeSeriesOptions) *TimeseriesModel {
+	if opts == nil {
+		return nil
+	}
+
+	data := &TimeseriesModel{
+		TimeField:             basetypes.NewStringValue(opts.TimeField),
+		MetaField:             basetypes.NewStringNull(),
+		Granularity:           basetypes.NewStringNull(),
+		BucketMaxSpanSeconds:  basetypes.NewInt64Null(),
+		BucketRoundingSeconds: basetypes.NewInt64Null(),
+	}
+	if opts.MetaField != "" {
+		data.MetaField = basetypes.NewStringValue(opts.MetaField)
+	}
+	if opts.Granularity != "" {
+		data.Granularity = basetypes.NewStringValue(opts.Granularity)
+	} else {
+		data.BucketMaxSpanSeconds = basetypes.NewInt64Value(opts.BucketMaxSpanSeconds)
+		data.BucketRoundingSeconds = basetypes.NewInt64Value(opts.BucketRoundingSeconds)
+	}
+	return data
+}
+
+// validateTimeseries checks the bucketing and the expiration of the time-series collection.
+func validateTimeseries(data *CollectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Timeseries == nil && data.ClusteredIndex == nil && !data.ExpireAfterSeconds.IsNull() {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("expire_after_seconds can be set only for time-series and clustered collections").ToDiagnostic(),
+		)
+		return diags
+	}
+	if !data.ExpireAfterSeconds.IsNull() && !data.ExpireAfterSeconds.IsUnknown() && data.ExpireAfterSeconds.ValueInt64() <= 0 {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("expire_after_seconds must be a positive number").ToDiagnostic(),
+		)
+	}
+	if data.Timeseries == nil {
+		return diags
+	}
+
+	if data.Capped.ValueBool() {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("time-series collections cannot be capped").ToDiagnostic(),
+		)
+	}
+
+	timeseries := data.Timeseries
+	if timeseries.BucketMaxSpanSeconds.IsUnknown() || timeseries.BucketRoundingSeconds.IsUnknown() {
+		return diags
+	}
+	if !timeseries.BucketMaxSpanSeconds.Equal(timeseries.BucketRoundingSeconds) {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("bucket_max_span_seconds and bucket_rounding_seconds must be set together to the same value").ToDiagnostic(),
+		)
+	}
+	if !timeseries.BucketMaxSpanSeconds.IsNull() && !timeseries.Granularity.IsNull() {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("granularity cannot be set with bucket_max_span_seconds and bucket_rounding_seconds").ToDiagnostic(),
+		)
+	}
+	return diags
+}
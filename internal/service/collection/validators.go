@@ -0,0 +1,142 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package collection
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	ejsonDocumentDescription    = "value must be a document in extended JSON"
+	validationLevelDescription  = fmt.Sprintf("validation level must be one of %s", strings.Join(mongoclient.ValidationLevels, ", "))
+	validationActionDescription = fmt.Sprintf("validation action must be one of %s", strings.Join(mongoclient.ValidationActions, ", "))
+	granularityDescription      = fmt.Sprintf("granularity must be one of %s", strings.Join(mongoclient.Granularities, ", "))
+)
+
+type isEJSONDocument struct {
+	validator.String
+}
+
+func IsEJSONDocument() validator.String {
+	return &isEJSONDocument{}
+}
+
+func (v *isEJSONDocument) Description(context.Context) string {
+	return ejsonDocumentDescription
+}
+
+func (v *isEJSONDocument) MarkdownDescription(context.Context) string {
+	return ejsonDocumentDescription
+}
+
+func (v *isEJSONDocument) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := mongoclient.ParseEJSONDocument(req.ConfigValue.ValueString()); err == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(ejsonDocumentDescription).ToDiagnostic(),
+	)
+}
+
+type isValidationLevel struct {
+	validator.String
+}
+
+func IsValidationLevel() validator.String {
+	return &isValidationLevel{}
+}
+
+func (v *isValidationLevel) Description(context.Context) string {
+	return validationLevelDescription
+}
+
+func (v *isValidationLevel) MarkdownDescription(context.Context) string {
+	return validationLevelDescription
+}
+
+func (v *isValidationLevel) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(mongoclient.ValidationLevels, req.ConfigValue.ValueString()) {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(validationLevelDescription).ToDiagnostic(),
+	)
+}
+
+type isValidationAction struct {
+	validator.String
+}
+
+func IsValidationAction() validator.String {
+	return &isValidationAction{}
+}
+
+func (v *isValidationAction) Description(context.Context) string {
+	return validationActionDescription
+}
+
+func (v *isValidationAction) MarkdownDescription(context.Context) string {
+	return validationActionDescription
+}
+
+func (v *isValidationAction) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(mongoclient.ValidationActions, req.ConfigValue.ValueString()) {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(validationActionDescription).ToDiagnostic(),
+	)
+}
+
+type isGranularity struct {
+	validator.String
+}
+
+func IsGranularity() validator.String {
+	return &isGranularity{}
+}
+
+func (v *isGranularity) Description(context.Context) string {
+	return granularityDescription
+}
+
+func (v *isGranularity) MarkdownDescription(context.Context) string {
+	return granularityDescription
+}
+
+func (v *isGranularity) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(mongoclient.Granularities, req.ConfigValue.ValueString()) {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(granularityDescription).ToDiagnostic(),
+	)
+}
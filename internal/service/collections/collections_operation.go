@@ -6,22 +6,21 @@ package collections
 import (
 	"fmt"
 	"regexp"
+	"sort"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"go.mongodb.org/mongo-driver/bson"
 )
 
 func dataSourceRead(client *mongoclient.MongoClient, data *CollectionsDataSourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	// Get the list of collections
-	ctx := client.Context()
 	database := data.Database.ValueString()
-	names, err := client.Client().Database(database).ListCollectionNames(ctx, bson.D{})
+	types, err := client.Database(database).ListCollectionTypes()
 
 	if err != nil {
 		diags.Append(errs.NewMongoClientError(err).ToDiagnostic())
@@ -35,6 +34,12 @@ func dataSourceRead(client *mongoclient.MongoClient, data *CollectionsDataSource
 		return diags
 	}
 
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
 	var matched []string
 	hasFilter := !(data.Name.IsNull() || data.Name.ValueString() == "")
 	if hasFilter {
@@ -63,6 +68,7 @@ func dataSourceRead(client *mongoclient.MongoClient, data *CollectionsDataSource
 				"id":       basetypes.NewStringValue(fmt.Sprintf("databases/%s/collections/%s", database, name)),
 				"database": basetypes.NewStringValue(database),
 				"name":     basetypes.NewStringValue(name),
+				"type":     basetypes.NewStringValue(types[name]),
 			},
 		)
 		if errs != nil {
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
 	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
 	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/zap"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -35,11 +37,19 @@ type CollectionsDataSourceModel struct {
 	Collections types.List   `tfsdk:"collections"`
 }
 
+// logFields returns the fields identifying the target of the operations in the logs.
+func (data *CollectionsDataSourceModel) logFields() []zap.Field {
+	return []zap.Field{
+		logging.String(logging.KeyDatabase, data.Database),
+	}
+}
+
 var CollectionElementType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
 		"id":       types.StringType,
 		"database": types.StringType,
 		"name":     types.StringType,
+		"type":     types.StringType,
 	},
 }
 
@@ -83,8 +93,19 @@ func (d *CollectionsDataSource) Configure(ctx context.Context, req datasource.Co
 }
 
 func (d *CollectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	client := mongoclient.New(ctx, d.config.ClientConfig).WithLogger(d.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data CollectionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := d.config.WithTimeout(ctx, "read", nil, &resp.Diagnostics)
+	defer done()
+
+	d.config.Run(ctx, "read", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -92,14 +113,6 @@ func (d *CollectionsDataSource) Read(ctx context.Context, req datasource.ReadReq
 			return
 		}
 
-		var data CollectionsDataSourceModel
-
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform the read operation
 		resp.Diagnostics.Append(dataSourceRead(client, &data)...)
 		if resp.Diagnostics.HasError() {
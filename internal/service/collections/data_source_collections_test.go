@@ -49,6 +49,7 @@ func TestAccCollectionsDataSource(t *testing.T) {
 						`, server.URI()),
 					Check: resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr("data.mongodb_database_collections.test", "collections.0.id", "databases/test-database/collections/test-collection"),
+						resource.TestCheckResourceAttr("data.mongodb_database_collections.test", "collections.0.type", "collection"),
 					),
 				},
 			},
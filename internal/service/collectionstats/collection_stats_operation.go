@@ -0,0 +1,90 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package collectionstats
+
+import (
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/service/collection"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/service/database"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func dataSourceRead(client *mongoclient.MongoClient, data *CollectionStatsDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Check if the database exists
+	database := database.CheckExistance(client, data.Database.ValueString(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Check if the collection exists
+	name := data.Name.ValueString()
+	target := database.Collection(name)
+	opts, err := target.Options()
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	if opts == nil {
+		diags.Append(
+			errs.NewCollectionNotFound(name).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	// Views have no storage of their own
+	if opts.Type == mongoclient.CollectionTypeView {
+		diags.Append(
+			errs.NewCollectionTypeMismatch(name, opts.Type, mongoclient.CollectionTypeCollection).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	// Read the statistics
+	stats, err := target.Stats()
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	indexSizes := make(map[string]attr.Value, len(stats.IndexSizes))
+	for index, size := range stats.IndexSizes {
+		indexSizes[index] = basetypes.NewInt64Value(size)
+	}
+	v, d := basetypes.NewMapValue(types.Int64Type, indexSizes)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.DocumentCount = basetypes.NewInt64Value(stats.Count)
+	data.AverageDocumentSize = basetypes.NewInt64Value(stats.AverageObjectSize)
+	data.DataSize = basetypes.NewInt64Value(stats.DataSize)
+	data.StorageSize = basetypes.NewInt64Value(stats.StorageSize)
+	data.TotalIndexSize = basetypes.NewInt64Value(stats.TotalIndexSize)
+	data.IndexSizes = v
+	data.Capped = basetypes.NewBoolValue(stats.Capped)
+	data.Sharded = basetypes.NewBoolValue(stats.Sharded)
+
+	// Set resource Id
+	resourceId, err := collection.CreateResourceId(data.Database, data.Name)
+	if err != nil {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration(err.Error()).ToDiagnostic(),
+		)
+		return diags
+	}
+	data.Id = resourceId
+
+	return diags
+}
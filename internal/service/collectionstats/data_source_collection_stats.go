@@ -0,0 +1,173 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package collectionstats
+
+import (
+	"context"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
+	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/zap"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CollectionStatsDataSource{}
+
+func NewCollectionStatsDataSource() datasource.DataSource {
+	return &CollectionStatsDataSource{}
+}
+
+// CollectionStatsDataSource defines the data source implementation.
+type CollectionStatsDataSource struct {
+	config *resourceconfig.ResourceConfig
+}
+
+// CollectionStatsDataSourceModel describes the data source data model.
+type CollectionStatsDataSourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Database            types.String `tfsdk:"database"`
+	Name                types.String `tfsdk:"name"`
+	DocumentCount       types.Int64  `tfsdk:"document_count"`
+	AverageDocumentSize types.Int64  `tfsdk:"average_document_size"`
+	DataSize            types.Int64  `tfsdk:"data_size"`
+	StorageSize         types.Int64  `tfsdk:"storage_size"`
+	TotalIndexSize      types.Int64  `tfsdk:"total_index_size"`
+	IndexSizes          types.Map    `tfsdk:"index_sizes"`
+	Capped              types.Bool   `tfsdk:"capped"`
+	Sharded             types.Bool   `tfsdk:"sharded"`
+}
+
+// logFields returns the fields identifying the target of the operations in the logs.
+func (data *CollectionStatsDataSourceModel) logFields() []zap.Field {
+	return []zap.Field{
+		logging.String(logging.KeyDatabase, data.Database),
+		logging.String(logging.KeyCollection, data.Name),
+	}
+}
+
+func (d *CollectionStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_collection_stats"
+}
+
+func (d *CollectionStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: mdutils.FormatResourceDescription(
+			`
+				This data source reads the storage statistics of a collection
+				with the %s aggregation stage.
+
+				The statistics are read on every plan, so that they can be checked
+				in %s blocks or preconditions. The statistics of the shards are summed up
+				for sharded collections.
+			`,
+			mdutils.InlineCodeBlock("$collStats"),
+			mdutils.InlineCodeBlock("check"),
+		),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Resource identifier.
+						
+						ID has a value with a format of the following:
+
+						%s
+					`,
+					mdutils.CodeBlock("", "databases/<database>/collections/<name>"),
+				),
+			},
+			"database": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the database to read the collection in.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the collection.",
+			},
+			"document_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of documents in the collection.",
+			},
+			"average_document_size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Average size of the documents in bytes.",
+			},
+			"data_size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Uncompressed size of the documents in bytes.",
+			},
+			"storage_size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size of the storage allocated for the documents in bytes, which is compressed.",
+			},
+			"total_index_size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size of all the indexes of the collection in bytes.",
+			},
+			"index_sizes": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Size of each index of the collection in bytes, keyed by the names of the indexes.",
+			},
+			"capped": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the collection is capped.",
+			},
+			"sharded": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the collection is sharded.",
+			},
+		},
+	}
+}
+
+func (d *CollectionStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	config, diags := resourceconfig.FromProviderData(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.config = config
+}
+
+func (d *CollectionStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CollectionStatsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := d.config.WithTimeout(ctx, "read", nil, &resp.Diagnostics)
+	defer done()
+
+	d.config.Run(ctx, "read", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
+		if err != nil {
+			resp.Diagnostics.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return
+		}
+
+		// Perform the read operation
+		resp.Diagnostics.Append(dataSourceRead(client, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	})
+}
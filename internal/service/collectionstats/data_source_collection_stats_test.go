@@ -0,0 +1,59 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package collectionstats_test
+
+import (
+	"testing"
+
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/provider"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/acc"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/mongolocal"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionStatsDataSource(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		mongoclient.FromURI(server.URI()).Run(func(client *mongoclient.MongoClient, err error) {
+			if err != nil {
+				logger.Sugar().Fatalf("failed to create a client: %v", err)
+			}
+
+			logger.Info("inserting documents to test the data source")
+			collection := client.Database("test-database").Collection("test-collection")
+			for i := 0; i < 3; i++ {
+				if _, err := collection.InsertOne(mongoclient.Document{"index": i}); err != nil {
+					logger.Sugar().Fatalf("failed to insert a document: %v", err)
+				}
+			}
+		})
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Read testing
+				{
+					Config: acc.WithProviderConfig(`
+						data "mongodb_database_collection_stats" "test" {
+							database = "test-database"
+							name = "test-collection"
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.mongodb_database_collection_stats.test", "id", "databases/test-database/collections/test-collection"),
+						resource.TestCheckResourceAttr("data.mongodb_database_collection_stats.test", "document_count", "3"),
+						resource.TestCheckResourceAttr("data.mongodb_database_collection_stats.test", "capped", "false"),
+						resource.TestCheckResourceAttr("data.mongodb_database_collection_stats.test", "sharded", "false"),
+						resource.TestCheckResourceAttrSet("data.mongodb_database_collection_stats.test", "data_size"),
+						resource.TestCheckResourceAttrSet("data.mongodb_database_collection_stats.test", "index_sizes._id_"),
+					),
+				},
+			},
+		})
+	})
+}
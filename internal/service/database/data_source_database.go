@@ -7,12 +7,14 @@ import (
 	"context"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
 	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
 	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/zap"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -33,6 +35,14 @@ type DatabaseDataSourceModel struct {
 	Name types.String `tfsdk:"name"`
 }
 
+// logFields returns the fields identifying the target of the operations in the logs.
+func (data *DatabaseDataSourceModel) logFields() []zap.Field {
+	return []zap.Field{
+		logging.String(logging.KeyDatabase, data.Name),
+		logging.String(logging.KeyID, data.Id),
+	}
+}
+
 func (d *DatabaseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_database"
 }
@@ -87,8 +97,19 @@ func (d *DatabaseDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	client := mongoclient.New(ctx, d.config.ClientConfig).WithLogger(d.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data DatabaseDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := d.config.WithTimeout(ctx, "read", nil, &resp.Diagnostics)
+	defer done()
+
+	d.config.Run(ctx, "read", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -96,14 +117,6 @@ func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadReques
 			return
 		}
 
-		var data DatabaseDataSourceModel
-
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform data source read operation
 		resp.Diagnostics.Append(dataSourceRead(client, &data)...)
 		if resp.Diagnostics.HasError() {
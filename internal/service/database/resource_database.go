@@ -7,11 +7,13 @@ import (
 	"context"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
 	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
 	resourceid "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/id"
 	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/zap"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -37,9 +40,18 @@ type DatabaseResource struct {
 
 // DatabaseResourceModel describes the resource data model.
 type DatabaseResourceModel struct {
-	Name         types.String `tfsdk:"name"`
-	Id           types.String `tfsdk:"id"`
-	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
+	Name         types.String   `tfsdk:"name"`
+	Id           types.String   `tfsdk:"id"`
+	ForceDestroy types.Bool     `tfsdk:"force_destroy"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// logFields returns the fields identifying the target of the operations in the logs.
+func (data *DatabaseResourceModel) logFields() []zap.Field {
+	return []zap.Field{
+		logging.String(logging.KeyDatabase, data.Name),
+		logging.String(logging.KeyID, data.Id),
+	}
 }
 
 func (r *DatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -110,6 +122,14 @@ func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -124,8 +144,22 @@ func (r *DatabaseResource) Configure(ctx context.Context, req resource.Configure
 }
 
 func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data DatabaseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "create", data.Timeouts.Create, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "create", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -133,14 +167,6 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 			return
 		}
 
-		var data DatabaseResourceModel
-
-		// Read Terraform plan data into the model
-		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform the create operation
 		resp.Diagnostics.Append(resourceCreate(client, &data)...)
 		if resp.Diagnostics.HasError() {
@@ -153,8 +179,22 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data DatabaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "read", data.Timeouts.Read, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "read", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -162,14 +202,6 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 			return
 		}
 
-		var data DatabaseResourceModel
-
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform the read operation
 		resp.Diagnostics.Append(resourceRead(client, &data)...)
 		if resp.Diagnostics.HasError() {
@@ -195,8 +227,22 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data DatabaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "delete", data.Timeouts.Delete, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "delete", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -204,14 +250,6 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 			return
 		}
 
-		var data DatabaseResourceModel
-
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform the delete operation
 		resp.Diagnostics.Append(resourceDelete(client, &data)...)
 	})
@@ -77,8 +77,19 @@ func (d *DatabasesDataSource) Configure(ctx context.Context, req datasource.Conf
 }
 
 func (d *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	client := mongoclient.New(ctx, d.config.ClientConfig).WithLogger(d.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data DatabasesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := d.config.WithTimeout(ctx, "read", nil, &resp.Diagnostics)
+	defer done()
+
+	d.config.Run(ctx, "read", nil, &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -86,14 +97,6 @@ func (d *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadReque
 			return
 		}
 
-		var data DatabasesDataSourceModel
-
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform the read operation
 		resp.Diagnostics.Append(dataSourceRead(client, &data)...)
 		if resp.Diagnostics.HasError() {
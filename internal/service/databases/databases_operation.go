@@ -12,15 +12,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"go.mongodb.org/mongo-driver/bson"
 )
 
 func dataSourceRead(client *mongoclient.MongoClient, data *DatabasesDataSourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	// Get the list of databases
-	ctx := client.Context()
-	names, err := client.Client().ListDatabaseNames(ctx, bson.D{})
+	names, err := client.ListDatabaseNames()
 
 	if err != nil {
 		diags.Append(errs.NewMongoClientError(err).ToDiagnostic())
@@ -259,6 +259,11 @@ func resourceUpdate(client *mongoclient.MongoClient, data *DocumentResourceModel
 	return diags
 }
 
+// moved reports whether the document has followed its collection renamed in place.
+func moved(plan *DocumentResourceModel, state *DocumentResourceModel) bool {
+	return !plan.Database.Equal(state.Database) || !plan.Collection.Equal(state.Collection)
+}
+
 func resourceDelete(client *mongoclient.MongoClient, data *DocumentResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -7,11 +7,16 @@ import (
 	"context"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	resourceconcern "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/concern"
 	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
 	resourceid "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/id"
 	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/service/collection"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,11 +25,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/zap"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DocumentResource{}
 var _ resource.ResourceWithImportState = &DocumentResource{}
+var _ resource.ResourceWithModifyPlan = &DocumentResource{}
 
 func NewDocumentResource() resource.Resource {
 	return &DocumentResource{}
@@ -37,12 +44,26 @@ type DocumentResource struct {
 
 // DocumentResourceModel describes the resource data model.
 type DocumentResourceModel struct {
-	Id               types.String `tfsdk:"id"`
-	Database         types.String `tfsdk:"database"`
-	Collection       types.String `tfsdk:"collection"`
-	DocumentId       types.String `tfsdk:"document_id"`
-	Document         types.String `tfsdk:"document"`
-	SyncWithDatabase types.Bool   `tfsdk:"sync_with_database"`
+	Id               types.String   `tfsdk:"id"`
+	Database         types.String   `tfsdk:"database"`
+	Collection       types.String   `tfsdk:"collection"`
+	DocumentId       types.String   `tfsdk:"document_id"`
+	Document         types.String   `tfsdk:"document"`
+	SyncWithDatabase types.Bool     `tfsdk:"sync_with_database"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+
+	ReadPreference *resourceconcern.ReadPreferenceModel `tfsdk:"read_preference"`
+	ReadConcern    types.String                         `tfsdk:"read_concern"`
+	WriteConcern   *resourceconcern.WriteConcernModel   `tfsdk:"write_concern"`
+}
+
+// logFields returns the fields identifying the target of the operations in the logs.
+func (data *DocumentResourceModel) logFields() []zap.Field {
+	return []zap.Field{
+		logging.String(logging.KeyDatabase, data.Database),
+		logging.String(logging.KeyCollection, data.Collection),
+		logging.String(logging.KeyID, data.Id),
+	}
 }
 
 func (r *DocumentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -80,17 +101,27 @@ func (r *DocumentResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"database": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Name of the database to create the collection in.",
+				Required: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(`
+					Name of the database to create the collection in.
+
+					The document follows its collection when the collection is moved into this database
+					with %s. Otherwise, changing it replaces the document.
+				`, mdutils.InlineCodeBlock("rename_in_place")),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					collection.RequiresReplaceUnlessFollowed(),
 				},
 			},
 			"collection": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Name of the collection to create the document in.",
+				Required: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(`
+					Name of the collection to create the document in.
+
+					The document follows its collection when the collection is renamed to this name
+					with %s. Otherwise, changing it replaces the document.
+				`, mdutils.InlineCodeBlock("rename_in_place")),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					collection.RequiresReplaceUnlessFollowed(),
 				},
 			},
 			"document_id": schema.StringAttribute{
@@ -156,6 +187,18 @@ func (r *DocumentResource) Schema(ctx context.Context, req resource.SchemaReques
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"read_concern": resourceconcern.ResourceReadConcernAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"read_preference": resourceconcern.ResourceReadPreferenceBlock(),
+			"write_concern":   resourceconcern.ResourceWriteConcernBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -171,18 +214,31 @@ func (r *DocumentResource) Configure(ctx context.Context, req resource.Configure
 }
 
 func (r *DocumentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data DocumentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "create", data.Timeouts.Create, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "create", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
 			)
 			return
 		}
-		var data DocumentResourceModel
 
-		// Read Terraform plan data into the model
-		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		// Apply the concerns of the resource
+		client = withConcern(ctx, client, &data, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -199,8 +255,22 @@ func (r *DocumentResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *DocumentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data DocumentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "read", data.Timeouts.Read, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "read", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -208,10 +278,8 @@ func (r *DocumentResource) Read(ctx context.Context, req resource.ReadRequest, r
 			return
 		}
 
-		var data DocumentResourceModel
-
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		// Apply the concerns of the resource
+		client = withConcern(ctx, client, &data, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -227,9 +295,44 @@ func (r *DocumentResource) Read(ctx context.Context, req resource.ReadRequest, r
 	})
 }
 
+func (r *DocumentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on creation and destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state DocumentResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The identifier of the document which follows its collection is known after the rename
+	if moved(&plan, &state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	}
+}
+
 func (r *DocumentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data DocumentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "update", data.Timeouts.Update, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "update", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -237,10 +340,8 @@ func (r *DocumentResource) Update(ctx context.Context, req resource.UpdateReques
 			return
 		}
 
-		var data DocumentResourceModel
-
-		// Read Terraform plan data into the model
-		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		// Apply the concerns of the resource
+		client = withConcern(ctx, client, &data, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -282,8 +383,22 @@ func (r *DocumentResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *DocumentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data DocumentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "delete", data.Timeouts.Delete, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "delete", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -291,10 +406,8 @@ func (r *DocumentResource) Delete(ctx context.Context, req resource.DeleteReques
 			return
 		}
 
-		var data DocumentResourceModel
-
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		// Apply the concerns of the resource
+		client = withConcern(ctx, client, &data, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -335,3 +448,21 @@ func (r *DocumentResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection"), id.Collection())...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("document_id"), id.Document())...)
 }
+
+// withConcern applies the read preference, the read concern
+// and the write concern of the resource to the client.
+func withConcern(ctx context.Context, client *mongoclient.MongoClient, data *DocumentResourceModel, diags *diag.Diagnostics) *mongoclient.MongoClient {
+	concern := resourceconcern.ToConfig(ctx, data.ReadPreference, data.ReadConcern, data.WriteConcern, diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	client, err := client.WithConcern(concern)
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return nil
+	}
+	return client
+}
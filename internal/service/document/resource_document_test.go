@@ -124,6 +124,71 @@ func TestAccDocumentResource_SyncWithDatabase(t *testing.T) {
 	})
 }
 
+func TestAccDocumentResource_Concern(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		setupDatabase(server)
+
+		logger.Info("running the test...")
+
+		tfFormat := getTFFormat()
+		compFormat := getCompareFormat()
+
+		document := getFirstDocument()
+		resourceWithConcern := func(readPreference string) string {
+			return fmt.Sprintf(`
+				resource "mongodb_database_document" "test" {
+					database     = "test-database"
+					collection   = "test-collection"
+					document     = "%s"
+					read_concern = "majority"
+
+					read_preference {
+						%s
+					}
+
+					write_concern {
+						w       = "majority"
+						journal = true
+					}
+				}
+			`, tfFormat.Apply(document), readPreference)
+		}
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Invalid read preference
+				{
+					Config: acc.WithProviderConfig(resourceWithConcern(`
+						mode          = "primary"
+						max_staleness = "2m"
+					`), server.URI()),
+					ExpectError: regexp.MustCompile(errs.NewInvalidInputValue("").Name()),
+				},
+				// Create and Read with the concerns of the resource
+				{
+					Config: acc.WithProviderConfig(resourceWithConcern(`
+						mode          = "primaryPreferred"
+						tag_sets      = [{ region = "east" }, {}]
+						max_staleness = "2m"
+					`), server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_document.test", "document", compFormat.Apply(document)),
+						resource.TestCheckResourceAttr("mongodb_database_document.test", "read_concern", "majority"),
+						resource.TestCheckResourceAttr("mongodb_database_document.test", "read_preference.mode", "primaryPreferred"),
+						resource.TestCheckResourceAttr("mongodb_database_document.test", "write_concern.w", "majority"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
 func documentResource(
 	database string,
 	collection string,
@@ -7,12 +7,15 @@ import (
 	"context"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	resourceconcern "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/concern"
 	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
 	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/zap"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -33,6 +36,17 @@ type DocumentsDataSourceModel struct {
 	Collection types.String `tfsdk:"collection"`
 	Filter     types.String `tfsdk:"filter"`
 	Documents  types.String `tfsdk:"documents"`
+
+	ReadPreference *resourceconcern.ReadPreferenceModel `tfsdk:"read_preference"`
+	ReadConcern    types.String                         `tfsdk:"read_concern"`
+}
+
+// logFields returns the fields identifying the target of the operations in the logs.
+func (data *DocumentsDataSourceModel) logFields() []zap.Field {
+	return []zap.Field{
+		logging.String(logging.KeyDatabase, data.Database),
+		logging.String(logging.KeyCollection, data.Collection),
+	}
 }
 
 func (d *DocumentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -91,6 +105,11 @@ func (d *DocumentsDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				),
 				Computed: true,
 			},
+			"read_concern": resourceconcern.DataSourceReadConcernAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"read_preference": resourceconcern.DataSourceReadPreferenceBlock(),
 		},
 	}
 }
@@ -106,8 +125,19 @@ func (d *DocumentsDataSource) Configure(ctx context.Context, req datasource.Conf
 }
 
 func (d *DocumentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	client := mongoclient.New(ctx, d.config.ClientConfig).WithLogger(d.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data DocumentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := d.config.WithTimeout(ctx, "read", nil, &resp.Diagnostics)
+	defer done()
+
+	d.config.Run(ctx, "read", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -115,13 +145,18 @@ func (d *DocumentsDataSource) Read(ctx context.Context, req datasource.ReadReque
 			return
 		}
 
-		var data DocumentsDataSourceModel
-
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+		// Apply the read preference and the read concern of the data source
+		concern := resourceconcern.ToConfig(ctx, data.ReadPreference, data.ReadConcern, nil, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		client, err = client.WithConcern(concern)
+		if err != nil {
+			resp.Diagnostics.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return
+		}
 
 		// Perform the read operation
 		resp.Diagnostics.Append(dataSourceRead(client, &data)...)
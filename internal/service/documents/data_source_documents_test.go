@@ -72,6 +72,36 @@ func TestAccDocumentsDataSource(t *testing.T) {
 								return fmt.Errorf("expected key to be 'value-2', got %s", decoded[0]["key"])
 							}
 
+							return nil
+						}),
+					),
+				},
+				// Read with the read preference and the read concern of the data source
+				{
+					Config: acc.WithProviderConfig(`
+						data "mongodb_database_documents" "test" {
+							database     = "test-database"
+							collection   = "test-collection"
+							filter       = jsonencode({})
+							read_concern = "local"
+
+							read_preference {
+								mode     = "secondaryPreferred"
+								tag_sets = [{ region = "east" }, {}]
+							}
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrWith("data.mongodb_database_documents.test", "documents", func(value string) error {
+							var decoded []map[string]interface{}
+							if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+								return err
+							}
+
+							if len(decoded) != 3 {
+								return fmt.Errorf("expected 3 documents, got %d", len(decoded))
+							}
+
 							return nil
 						}),
 					),
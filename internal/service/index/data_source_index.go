@@ -7,12 +7,14 @@ import (
 	"context"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
 	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
 	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/zap"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -29,13 +31,36 @@ type IndexDataSource struct {
 
 // IndexDataSourceModel describes the data source data model.
 type IndexDataSourceModel struct {
-	Id         types.String `tfsdk:"id"`
-	Database   types.String `tfsdk:"database"`
-	Collection types.String `tfsdk:"collection"`
-	IndexName  types.String `tfsdk:"index_name"`
-	Field      types.String `tfsdk:"field"`
-	Direction  types.Int64  `tfsdk:"direction"`
-	Unique     types.Bool   `tfsdk:"unique"`
+	Id                      types.String  `tfsdk:"id"`
+	Database                types.String  `tfsdk:"database"`
+	Collection              types.String  `tfsdk:"collection"`
+	IndexName               types.String  `tfsdk:"index_name"`
+	Keys                    types.List    `tfsdk:"keys"`
+	Field                   types.String  `tfsdk:"field"`
+	Direction               types.Int64   `tfsdk:"direction"`
+	Unique                  types.Bool    `tfsdk:"unique"`
+	ExpireAfterSeconds      types.Int64   `tfsdk:"expire_after_seconds"`
+	PartialFilterExpression types.String  `tfsdk:"partial_filter_expression"`
+	Sparse                  types.Bool    `tfsdk:"sparse"`
+	Hidden                  types.Bool    `tfsdk:"hidden"`
+	Weights                 types.Map     `tfsdk:"weights"`
+	DefaultLanguage         types.String  `tfsdk:"default_language"`
+	LanguageOverride        types.String  `tfsdk:"language_override"`
+	TextIndexVersion        types.Int64   `tfsdk:"text_index_version"`
+	SphereIndexVersion      types.Int64   `tfsdk:"sphere_index_version"`
+	Bits                    types.Int64   `tfsdk:"bits"`
+	Min                     types.Float64 `tfsdk:"min"`
+	Max                     types.Float64 `tfsdk:"max"`
+	WildcardProjection      types.String  `tfsdk:"wildcard_projection"`
+}
+
+// logFields returns the fields identifying the target of the operations in the logs.
+func (data *IndexDataSourceModel) logFields() []zap.Field {
+	return []zap.Field{
+		logging.String(logging.KeyDatabase, data.Database),
+		logging.String(logging.KeyCollection, data.Collection),
+		logging.String(logging.KeyID, data.Id),
+	}
 }
 
 func (d *IndexDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,8 +71,9 @@ func (d *IndexDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: mdutils.FormatResourceDescription(`
-			This data source reads an index for single field in a collection 
-			in a database on the MongoDB server.
+			This data source reads an index for single field or compound keys
+			in a collection in a database on the MongoDB server, including
+			the text, geospatial and wildcard indexes.
 		`),
 
 		Attributes: map[string]schema.Attribute{
@@ -76,18 +102,92 @@ func (d *IndexDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Required:            true,
 				MarkdownDescription: "Name of the index.",
 			},
+			"keys": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Ordered keys of the index.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the indexed field.",
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+							MarkdownDescription: mdutils.FormatSchemaDescription(
+								`
+									Type of the key, which is one of %s.
+								`,
+								mdutils.InlineCodeBlocks(mongoclient.IndexKeyTypes),
+							),
+						},
+					},
+				},
+			},
 			"field": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Name of the field to read the index on.",
+				MarkdownDescription: "Name of the field of the first key.",
 			},
 			"direction": schema.Int64Attribute{
 				Computed:            true,
-				MarkdownDescription: "Direction of the index. 1 for ascending, -1 for descending.",
+				MarkdownDescription: "Direction of the first key. 1 for ascending, -1 for descending, unset if the key has no direction.",
 			},
 			"unique": schema.BoolAttribute{
 				Computed:            true,
 				MarkdownDescription: "If true, this index has a unique constraint.",
 			},
+			"expire_after_seconds": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of seconds after which the TTL index removes the documents, unset if this index is not a TTL index.",
+			},
+			"partial_filter_expression": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Filter in extended JSON of the documents the partial index indexes, unset if this index is not a partial index.",
+			},
+			"sparse": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "If true, this index skips the documents missing the indexed fields.",
+			},
+			"hidden": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "If true, this index is hidden from the query planner.",
+			},
+			"weights": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Weights of the fields of the text index, unset if this index is not a text index.",
+			},
+			"default_language": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Language of the text index, unset if this index is not a text index.",
+			},
+			"language_override": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the field of the documents holding their language for the text index, unset if this index is not a text index.",
+			},
+			"text_index_version": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Version of the text index, unset if this index is not a text index.",
+			},
+			"sphere_index_version": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Version of the 2dsphere index, unset if this index is not a 2dsphere index.",
+			},
+			"bits": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Precision of the coordinates of the 2d index in bits, unset if it is not set for the index.",
+			},
+			"min": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Lower bound of the coordinates of the 2d index, unset if it is not set for the index.",
+			},
+			"max": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Upper bound of the coordinates of the 2d index, unset if it is not set for the index.",
+			},
+			"wildcard_projection": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Projection in extended JSON selecting the fields indexed by the wildcard index, unset if it is not set for the index.",
+			},
 		},
 	}
 }
@@ -103,8 +203,19 @@ func (d *IndexDataSource) Configure(ctx context.Context, req datasource.Configur
 }
 
 func (d *IndexDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	client := mongoclient.New(ctx, d.config.ClientConfig).WithLogger(d.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data IndexDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := d.config.WithTimeout(ctx, "read", nil, &resp.Diagnostics)
+	defer done()
+
+	d.config.Run(ctx, "read", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -112,14 +223,6 @@ func (d *IndexDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			return
 		}
 
-		var data IndexDataSourceModel
-
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform read operation
 		resp.Diagnostics.Append(dataSourceRead(client, &data)...)
 		if resp.Diagnostics.HasError() {
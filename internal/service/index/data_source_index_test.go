@@ -41,7 +41,12 @@ func TestAccIndexDataSource(t *testing.T) {
 						resource.TestCheckResourceAttr("data.mongodb_database_index.test", "collection", "test-collection"),
 						resource.TestCheckResourceAttr("data.mongodb_database_index.test", "field", "test-field"),
 						resource.TestCheckResourceAttr("data.mongodb_database_index.test", "direction", "1"),
+						resource.TestCheckResourceAttr("data.mongodb_database_index.test", "keys.#", "1"),
+						resource.TestCheckResourceAttr("data.mongodb_database_index.test", "keys.0.field", "test-field"),
+						resource.TestCheckResourceAttr("data.mongodb_database_index.test", "keys.0.type", "1"),
 						resource.TestCheckResourceAttr("data.mongodb_database_index.test", "unique", "false"),
+						resource.TestCheckResourceAttr("data.mongodb_database_index.test", "sparse", "false"),
+						resource.TestCheckResourceAttr("data.mongodb_database_index.test", "hidden", "false"),
 					),
 				},
 			},
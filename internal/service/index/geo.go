@@ -0,0 +1,118 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package index
+
+import (
+	"fmt"
+	"slices"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// MaxGeoIndexBits is the largest precision of the coordinates of the 2d index.
+const MaxGeoIndexBits = 32
+
+// SphereIndexVersions are the versions of the 2dsphere index supported by the server.
+var SphereIndexVersions = []int64{1, 2, 3}
+
+// geoIndexOptions sets the options of the geospatial index in the resource to the options.
+func geoIndexOptions(data *IndexResourceModel, opts *mongoclient.IndexOptions) {
+	if !data.SphereIndexVersion.IsUnknown() {
+		opts.SphereIndexVersion = int32(data.SphereIndexVersion.ValueInt64())
+	}
+	if !data.Bits.IsUnknown() {
+		opts.Bits = int32(data.Bits.ValueInt64())
+	}
+	if !data.Min.IsNull() && !data.Min.IsUnknown() {
+		min := data.Min.ValueFloat64()
+		opts.Min = &min
+	}
+	if !data.Max.IsNull() && !data.Max.IsUnknown() {
+		max := data.Max.ValueFloat64()
+		opts.Max = &max
+	}
+}
+
+// readGeoIndexOptions sets the options of the geospatial index into the data source,
+// which are unset if the index is not a geospatial index.
+func readGeoIndexOptions(opts mongoclient.IndexOptions, data *IndexDataSourceModel) {
+	data.SphereIndexVersion = basetypes.NewInt64Null()
+	if opts.SphereIndexVersion != 0 {
+		data.SphereIndexVersion = basetypes.NewInt64Value(int64(opts.SphereIndexVersion))
+	}
+	data.Bits = basetypes.NewInt64Null()
+	if opts.Bits != 0 {
+		data.Bits = basetypes.NewInt64Value(int64(opts.Bits))
+	}
+	data.Min = basetypes.NewFloat64Null()
+	if opts.Min != nil {
+		data.Min = basetypes.NewFloat64Value(*opts.Min)
+	}
+	data.Max = basetypes.NewFloat64Null()
+	if opts.Max != nil {
+		data.Max = basetypes.NewFloat64Value(*opts.Max)
+	}
+}
+
+// validateGeo checks the options of the geospatial index are set only for its keys.
+func validateGeo(data *IndexResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Keys.IsUnknown() {
+		return diags
+	}
+	keys, d := configuredKeys(data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	sphere := slices.ContainsFunc(keys, func(key mongoclient.IndexKey) bool { return key.Type == mongoclient.IndexKey2DSphere })
+	planar := slices.ContainsFunc(keys, func(key mongoclient.IndexKey) bool { return key.Type == mongoclient.IndexKey2D })
+
+	if !sphere && !data.SphereIndexVersion.IsNull() {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("sphere_index_version can be set only for 2dsphere indexes").ToDiagnostic(),
+		)
+	}
+	if !data.SphereIndexVersion.IsNull() && !data.SphereIndexVersion.IsUnknown() && !slices.Contains(SphereIndexVersions, data.SphereIndexVersion.ValueInt64()) {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration(fmt.Sprintf("sphere_index_version must be one of %v", SphereIndexVersions)).ToDiagnostic(),
+		)
+	}
+
+	if !planar {
+		options := map[string]attr.Value{
+			"bits": data.Bits,
+			"min":  data.Min,
+			"max":  data.Max,
+		}
+		for _, attribute := range []string{"bits", "min", "max"} {
+			if !options[attribute].IsNull() {
+				diags.Append(
+					errs.NewInvalidResourceConfiguration(fmt.Sprintf("%s can be set only for 2d indexes", attribute)).ToDiagnostic(),
+				)
+			}
+		}
+		return diags
+	}
+	if !data.Bits.IsNull() && !data.Bits.IsUnknown() && (data.Bits.ValueInt64() < 1 || data.Bits.ValueInt64() > MaxGeoIndexBits) {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration(fmt.Sprintf("bits must be between 1 and %d", MaxGeoIndexBits)).ToDiagnostic(),
+		)
+	}
+	if data.Min.IsNull() || data.Min.IsUnknown() || data.Max.IsNull() || data.Max.IsUnknown() {
+		return diags
+	}
+	if data.Min.ValueFloat64() >= data.Max.ValueFloat64() {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("min must be less than max").ToDiagnostic(),
+		)
+	}
+	return diags
+}
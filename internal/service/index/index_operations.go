@@ -5,6 +5,7 @@ package index
 
 import (
 	"fmt"
+	"strconv"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
@@ -77,9 +78,20 @@ func dataSourceRead(client *mongoclient.MongoClient, data *IndexDataSourceModel)
 	data.Collection = basetypes.NewStringValue(index.Collection().Name())
 	data.Database = basetypes.NewStringValue(index.Database().Name())
 	data.IndexName = basetypes.NewStringValue(index.Name())
-	data.Field = basetypes.NewStringValue(index.Field())
-	data.Direction = basetypes.NewInt64Value(int64(index.Direction()))
-	data.Unique = basetypes.NewBoolValue(index.Unique())
+	keys, d := readKeys(index.Keys(), &data.Keys)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Field = basetypes.NewStringNull()
+	data.Direction = basetypes.NewInt64Null()
+	if len(keys) > 0 {
+		data.Field = basetypes.NewStringValue(keys[0].Field)
+		if direction, err := strconv.ParseInt(keys[0].Type, 10, 64); err == nil {
+			data.Direction = basetypes.NewInt64Value(direction)
+		}
+	}
+	diags.Append(readIndexOptions(index.Options(), data)...)
 
 	return diags
 }
@@ -87,13 +99,27 @@ func dataSourceRead(client *mongoclient.MongoClient, data *IndexDataSourceModel)
 func resourceRead(client *mongoclient.MongoClient, data *IndexResourceModel) diag.Diagnostics {
 	// Type cast the resource data to data source data
 	d := &IndexDataSourceModel{
-		Id:         data.Id,
-		Database:   data.Database,
-		Collection: data.Collection,
-		IndexName:  data.IndexName,
-		Field:      data.Field,
-		Direction:  data.Direction,
-		Unique:     data.Unique,
+		Id:                      data.Id,
+		Database:                data.Database,
+		Collection:              data.Collection,
+		IndexName:               data.IndexName,
+		Keys:                    data.Keys,
+		Field:                   data.Field,
+		Direction:               data.Direction,
+		Unique:                  data.Unique,
+		ExpireAfterSeconds:      data.ExpireAfterSeconds,
+		PartialFilterExpression: data.PartialFilterExpression,
+		Sparse:                  data.Sparse,
+		Hidden:                  data.Hidden,
+		Weights:                 data.Weights,
+		DefaultLanguage:         data.DefaultLanguage,
+		LanguageOverride:        data.LanguageOverride,
+		TextIndexVersion:        data.TextIndexVersion,
+		SphereIndexVersion:      data.SphereIndexVersion,
+		Bits:                    data.Bits,
+		Min:                     data.Min,
+		Max:                     data.Max,
+		WildcardProjection:      data.WildcardProjection,
 	}
 
 	// Read the data source
@@ -104,9 +130,23 @@ func resourceRead(client *mongoclient.MongoClient, data *IndexResourceModel) dia
 	data.Database = d.Database
 	data.Collection = d.Collection
 	data.IndexName = d.IndexName
+	data.Keys = d.Keys
 	data.Field = d.Field
 	data.Direction = d.Direction
 	data.Unique = d.Unique
+	data.ExpireAfterSeconds = d.ExpireAfterSeconds
+	data.PartialFilterExpression = d.PartialFilterExpression
+	data.Sparse = d.Sparse
+	data.Hidden = d.Hidden
+	data.Weights = d.Weights
+	data.DefaultLanguage = d.DefaultLanguage
+	data.LanguageOverride = d.LanguageOverride
+	data.TextIndexVersion = d.TextIndexVersion
+	data.SphereIndexVersion = d.SphereIndexVersion
+	data.Bits = d.Bits
+	data.Min = d.Min
+	data.Max = d.Max
+	data.WildcardProjection = d.WildcardProjection
 
 	return diags
 }
@@ -127,10 +167,20 @@ func resourceCreate(client *mongoclient.MongoClient, data *IndexResourceModel) d
 	}
 
 	// Create the index
-	field := data.Field.ValueString()
-	direction := int(data.Direction.ValueInt64())
-	unique := data.Unique.ValueBool()
-	index := collection.IndexFromField(field, direction, unique)
+	keys, d := configuredKeys(data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	opts, d := indexOptions(data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	index := collection.IndexFromKeys(keys, opts)
+	if !data.IndexName.IsNull() && !data.IndexName.IsUnknown() {
+		index.WithName(data.IndexName.ValueString())
+	}
 	if err := index.EnsureExistance(); err != nil {
 		diags.Append(
 			errs.NewMongoClientError(err).ToDiagnostic(),
@@ -138,13 +188,16 @@ func resourceCreate(client *mongoclient.MongoClient, data *IndexResourceModel) d
 		return diags
 	}
 
+	// Warn the documents which the TTL index never expires
+	diags.Append(expirationWarnings(index)...)
+
 	// Get the index name
 	name := index.Name()
 
 	// If the index name is not set,
-	// infer it from the field name and direction
+	// infer it from the keys
 	if name == "" {
-		name = fmt.Sprintf("%s_%d", field, direction)
+		name = mongoclient.DefaultIndexName(keys)
 	}
 
 	// Set index name
@@ -156,6 +209,87 @@ func resourceCreate(client *mongoclient.MongoClient, data *IndexResourceModel) d
 	return diags
 }
 
+// moved reports whether the index has followed its collection renamed in place.
+func moved(plan *IndexResourceModel, state *IndexResourceModel) bool {
+	return !plan.Database.Equal(state.Database) || !plan.Collection.Equal(state.Collection)
+}
+
+// modified reports whether the plan changes the options of the index applied in place.
+func modified(plan *IndexResourceModel, state *IndexResourceModel) bool {
+	return !plan.ExpireAfterSeconds.Equal(state.ExpireAfterSeconds) || !plan.Hidden.Equal(state.Hidden)
+}
+
+// resourceUpdate reads the index which has followed its collection renamed in place,
+// and changes the expiration and the visibility of the index in place.
+func resourceUpdate(client *mongoclient.MongoClient, data *IndexResourceModel, state *IndexResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The data is overwritten by the index read from the server
+	planned := *data
+
+	diags.Append(resourceRead(client, data)...)
+	if diags.HasError() || !modified(&planned, data) {
+		return diags
+	}
+
+	// Check if the database exists
+	database := database.CheckExistance(client, data.Database.ValueString(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Check if the collection exists
+	collection := collection.CheckExistance(database, data.Collection.ValueString(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Check if the index exists
+	index := collection.Index(data.IndexName.ValueString())
+	spec, err := index.GetSpec()
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	if spec == nil {
+		diags.Append(
+			errs.NewIndexNotFound(data.IndexName.ValueString()).ToDiagnostic(),
+		)
+		return diags
+	}
+	index.Hydrate(spec)
+
+	// Change the expiration of the index
+	if !planned.ExpireAfterSeconds.Equal(data.ExpireAfterSeconds) {
+		if err := index.SetExpireAfterSeconds(int32(planned.ExpireAfterSeconds.ValueInt64())); err != nil {
+			diags.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return diags
+		}
+
+		// Warn the documents which the TTL index never expires
+		diags.Append(expirationWarnings(index)...)
+	}
+
+	// Hide or unhide the index
+	if !planned.Hidden.Equal(data.Hidden) {
+		if err := index.SetHidden(planned.Hidden.ValueBool()); err != nil {
+			diags.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return diags
+		}
+	}
+
+	// Perform read operation
+	diags.Append(resourceRead(client, data)...)
+
+	return diags
+}
+
 func resourceDelete(client *mongoclient.MongoClient, data *IndexResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
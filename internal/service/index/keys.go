@@ -0,0 +1,198 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package index
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// indexKeyAttributeTypes are the types of the attributes of a key of the index.
+var indexKeyAttributeTypes = map[string]attr.Type{
+	"field": types.StringType,
+	"type":  types.StringType,
+}
+
+// configuredKeys returns the keys of the index configured either
+// by the keys list or by the single field and its direction.
+func configuredKeys(data *IndexResourceModel) ([]mongoclient.IndexKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.Keys.IsNull() || data.Keys.IsUnknown() {
+		direction := int64(1)
+		if !data.Direction.IsNull() && !data.Direction.IsUnknown() {
+			direction = data.Direction.ValueInt64()
+		}
+		return []mongoclient.IndexKey{{
+			Field: data.Field.ValueString(),
+			Type:  strconv.FormatInt(direction, 10),
+		}}, diags
+	}
+
+	return listKeys(data.Keys)
+}
+
+// listKeys returns the keys in the keys list.
+func listKeys(list basetypes.ListValue) ([]mongoclient.IndexKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	keys := make([]mongoclient.IndexKey, 0, len(list.Elements()))
+	for _, element := range list.Elements() {
+		object, ok := element.(basetypes.ObjectValue)
+		if !ok {
+			diags.Append(
+				errs.NewUnexpectedError(fmt.Errorf("unexpected index key %s", element)).ToDiagnostic(),
+			)
+			return nil, diags
+		}
+		attributes := object.Attributes()
+		field, _ := attributes["field"].(basetypes.StringValue)
+		keyType, _ := attributes["type"].(basetypes.StringValue)
+		keys = append(keys, mongoclient.IndexKey{
+			Field: field.ValueString(),
+			Type:  keyType.ValueString(),
+		})
+	}
+	return keys, diags
+}
+
+// readKeys sets the keys of the index to the keys list, and returns the keys in the list.
+// The keys in the list are kept if they are the keys of the index in another order
+// the server does not keep, so that the order of the text keys is not reported as drift.
+func readKeys(keys []mongoclient.IndexKey, list *basetypes.ListValue) ([]mongoclient.IndexKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !list.IsNull() && !list.IsUnknown() {
+		current, d := listKeys(*list)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if mongoclient.EqualIndexKeys(current, keys) {
+			return current, diags
+		}
+	}
+
+	elements := make([]attr.Value, 0, len(keys))
+	for _, key := range keys {
+		elements = append(elements, basetypes.NewObjectValueMust(indexKeyAttributeTypes, map[string]attr.Value{
+			"field": basetypes.NewStringValue(key.Field),
+			"type":  basetypes.NewStringValue(key.Type),
+		}))
+	}
+	value, d := basetypes.NewListValue(basetypes.ObjectType{AttrTypes: indexKeyAttributeTypes}, elements)
+	diags.Append(d...)
+	*list = value
+	return keys, diags
+}
+
+// requiresReplaceUnlessEqualKeys replaces the index when the keys change,
+// unless they are the keys of the same index in another order the server does not keep.
+func requiresReplaceUnlessEqualKeys(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.PlanValue.IsUnknown() || req.StateValue.IsNull() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	planned, diags := listKeys(req.PlanValue)
+	resp.Diagnostics.Append(diags...)
+	current, diags := listKeys(req.StateValue)
+	resp.Diagnostics.Append(diags...)
+	resp.RequiresReplace = !mongoclient.EqualIndexKeys(planned, current)
+}
+
+// validateKeys checks the index is configured either by the keys list
+// or by the single field.
+func validateKeys(data *IndexResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Field.IsUnknown() || data.Keys.IsUnknown() {
+		return diags
+	}
+	if data.Field.IsNull() == data.Keys.IsNull() {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("exactly one of field and keys must be set").ToDiagnostic(),
+		)
+		return diags
+	}
+	if !data.Keys.IsNull() && !data.Direction.IsNull() {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("direction can be set only with field, use the type of the keys instead").ToDiagnostic(),
+		)
+	}
+	if !data.Keys.IsNull() && len(data.Keys.Elements()) == 0 {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("keys must have at least one key").ToDiagnostic(),
+		)
+		return diags
+	}
+	if data.Keys.IsNull() {
+		return diags
+	}
+
+	keys, d := listKeys(data.Keys)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(validateKeyTypes(keys)...)
+	return diags
+}
+
+// validateKeyTypes checks the keys can be combined in an index by their types.
+// The special index types other than the directions cannot be mixed,
+// and an index has at most one 2d or hashed key, where the 2d key must be the first key.
+func validateKeyTypes(keys []mongoclient.IndexKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := make(map[string]bool, len(keys))
+	special := ""
+	counts := make(map[string]int, len(keys))
+	for position, key := range keys {
+		// The fields and the types not known yet are left to the server
+		if fields[key.Field] {
+			diags.Append(
+				errs.NewInvalidResourceConfiguration(fmt.Sprintf("%s is indexed by more than one key", key.Field)).ToDiagnostic(),
+			)
+		}
+		if key.Field != "" {
+			fields[key.Field] = true
+		}
+
+		if key.Type == "" || key.Type == mongoclient.IndexKeyAscending || key.Type == mongoclient.IndexKeyDescending {
+			continue
+		}
+		if special != "" && special != key.Type {
+			diags.Append(
+				errs.NewInvalidResourceConfiguration(fmt.Sprintf("%s keys cannot be combined with %s keys in an index", key.Type, special)).ToDiagnostic(),
+			)
+		}
+		special = key.Type
+		counts[key.Type]++
+
+		if key.Type == mongoclient.IndexKey2D && position != 0 {
+			diags.Append(
+				errs.NewInvalidResourceConfiguration("2d key must be the first key of the index").ToDiagnostic(),
+			)
+		}
+	}
+	for _, keyType := range []string{mongoclient.IndexKey2D, mongoclient.IndexKeyHashed} {
+		if counts[keyType] > 1 {
+			diags.Append(
+				errs.NewInvalidResourceConfiguration(fmt.Sprintf("an index can have only one %s key", keyType)).ToDiagnostic(),
+			)
+		}
+	}
+	return diags
+}
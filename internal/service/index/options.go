@@ -0,0 +1,163 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package index
+
+import (
+	"context"
+	"fmt"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// indexOptions returns the options of the index in the resource.
+func indexOptions(data *IndexResourceModel) (*mongoclient.IndexOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := &mongoclient.IndexOptions{
+		Unique:                  data.Unique.ValueBool(),
+		ExpireAfterSeconds:      nil,
+		PartialFilterExpression: nil,
+		WildcardProjection:      nil,
+		Sparse:                  data.Sparse.ValueBool(),
+		Hidden:                  data.Hidden.ValueBool(),
+	}
+	if !data.ExpireAfterSeconds.IsNull() && !data.ExpireAfterSeconds.IsUnknown() {
+		seconds := int32(data.ExpireAfterSeconds.ValueInt64())
+		opts.ExpireAfterSeconds = &seconds
+	}
+	if !data.PartialFilterExpression.IsNull() {
+		filter, err := mongoclient.ParseEJSONDocument(data.PartialFilterExpression.ValueString())
+		if err != nil {
+			diags.Append(
+				errs.NewEJsonParseError(err).ToDiagnostic(),
+			)
+			return nil, diags
+		}
+		opts.PartialFilterExpression = filter
+	}
+	if !data.WildcardProjection.IsNull() {
+		projection, err := mongoclient.ParseEJSONDocument(data.WildcardProjection.ValueString())
+		if err != nil {
+			diags.Append(
+				errs.NewEJsonParseError(err).ToDiagnostic(),
+			)
+			return nil, diags
+		}
+		opts.WildcardProjection = projection
+	}
+	textIndexOptions(data, opts)
+	geoIndexOptions(data, opts)
+	return opts, diags
+}
+
+// readIndexOptions sets the options of the index into the data source.
+func readIndexOptions(opts mongoclient.IndexOptions, data *IndexDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Unique = basetypes.NewBoolValue(opts.Unique)
+	data.Sparse = basetypes.NewBoolValue(opts.Sparse)
+	data.Hidden = basetypes.NewBoolValue(opts.Hidden)
+	data.ExpireAfterSeconds = basetypes.NewInt64Null()
+	if opts.ExpireAfterSeconds != nil {
+		data.ExpireAfterSeconds = basetypes.NewInt64Value(int64(*opts.ExpireAfterSeconds))
+	}
+	diags.Append(readEJSONOption(opts.PartialFilterExpression, &data.PartialFilterExpression)...)
+	diags.Append(readEJSONOption(opts.WildcardProjection, &data.WildcardProjection)...)
+	diags.Append(readTextIndexOptions(opts, data)...)
+	readGeoIndexOptions(opts, data)
+	return diags
+}
+
+// readEJSONOption sets the document option of the index to the attribute,
+// keeping the formatting of the attribute if the option is equal to it.
+func readEJSONOption(option bson.Raw, attribute *basetypes.StringValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(option) == 0 {
+		*attribute = basetypes.NewStringNull()
+		return diags
+	}
+
+	value, err := mongoclient.EJSONString(option)
+	if err != nil {
+		diags.Append(
+			errs.NewEJsonParseError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	if attribute.IsNull() || attribute.IsUnknown() || !mongoclient.EqualEJSON(attribute.ValueString(), value) {
+		*attribute = basetypes.NewStringValue(value)
+	}
+	return diags
+}
+
+// requiresReplaceIfExpirationToggled replaces the index when it is made
+// a TTL index or no longer a TTL index, which collMod cannot do.
+func requiresReplaceIfExpirationToggled(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	if req.PlanValue.IsUnknown() {
+		return
+	}
+
+	resp.RequiresReplace = req.PlanValue.IsNull() != req.StateValue.IsNull()
+}
+
+// validateExpiration checks the expiration of the TTL index, which removes
+// the documents only by a single field index of a date field.
+func validateExpiration(data *IndexResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.ExpireAfterSeconds.IsNull() || data.ExpireAfterSeconds.IsUnknown() {
+		return diags
+	}
+	if seconds := data.ExpireAfterSeconds.ValueInt64(); seconds < 0 || seconds > mongoclient.MaxExpireAfterSeconds {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration(fmt.Sprintf("expire_after_seconds must be between 0 and %d", mongoclient.MaxExpireAfterSeconds)).ToDiagnostic(),
+		)
+	}
+	if data.Keys.IsNull() || data.Keys.IsUnknown() {
+		return diags
+	}
+
+	keys, d := configuredKeys(data)
+	diags.Append(d...)
+	if len(keys) > 1 {
+		diags.Append(
+			errs.NewExpirationNotApplied(keys[0].Field, "TTL indexes must have a single key").ToDiagnostic(),
+		)
+	} else if len(keys) == 1 && keys[0].Type != mongoclient.IndexKeyAscending && keys[0].Type != mongoclient.IndexKeyDescending {
+		diags.Append(
+			errs.NewExpirationNotApplied(keys[0].Field, fmt.Sprintf("TTL indexes cannot have %s keys", keys[0].Type)).ToDiagnostic(),
+		)
+	}
+	return diags
+}
+
+// expirationWarnings warns the TTL index has documents which never expire,
+// as their indexed field has no date.
+func expirationWarnings(index *mongoclient.Index) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if index.Options().ExpireAfterSeconds == nil {
+		return diags
+	}
+	found, err := index.HasNonDateValues()
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	if found {
+		diags.Append(
+			errs.NewExpirationNotApplied(index.Field(), "some documents have values other than dates in the field").ToDiagnostic(),
+		)
+	}
+	return diags
+}
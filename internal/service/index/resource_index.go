@@ -7,27 +7,36 @@ import (
 	"context"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
 	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
 	resourceid "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/id"
 	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/service/collection"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/zap"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IndexResource{}
 var _ resource.ResourceWithImportState = &IndexResource{}
+var _ resource.ResourceWithModifyPlan = &IndexResource{}
+var _ resource.ResourceWithValidateConfig = &IndexResource{}
 
 func NewIndexResource() resource.Resource {
 	return &IndexResource{}
@@ -40,14 +49,38 @@ type IndexResource struct {
 
 // IndexResourceModel describes the resource data model.
 type IndexResourceModel struct {
-	Id           types.String `tfsdk:"id"`
-	Database     types.String `tfsdk:"database"`
-	Collection   types.String `tfsdk:"collection"`
-	IndexName    types.String `tfsdk:"index_name"`
-	Field        types.String `tfsdk:"field"`
-	Direction    types.Int64  `tfsdk:"direction"`
-	Unique       types.Bool   `tfsdk:"unique"`
-	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
+	Id                      types.String   `tfsdk:"id"`
+	Database                types.String   `tfsdk:"database"`
+	Collection              types.String   `tfsdk:"collection"`
+	IndexName               types.String   `tfsdk:"index_name"`
+	Keys                    types.List     `tfsdk:"keys"`
+	Field                   types.String   `tfsdk:"field"`
+	Direction               types.Int64    `tfsdk:"direction"`
+	Unique                  types.Bool     `tfsdk:"unique"`
+	ExpireAfterSeconds      types.Int64    `tfsdk:"expire_after_seconds"`
+	PartialFilterExpression types.String   `tfsdk:"partial_filter_expression"`
+	Sparse                  types.Bool     `tfsdk:"sparse"`
+	Hidden                  types.Bool     `tfsdk:"hidden"`
+	Weights                 types.Map      `tfsdk:"weights"`
+	DefaultLanguage         types.String   `tfsdk:"default_language"`
+	LanguageOverride        types.String   `tfsdk:"language_override"`
+	TextIndexVersion        types.Int64    `tfsdk:"text_index_version"`
+	SphereIndexVersion      types.Int64    `tfsdk:"sphere_index_version"`
+	Bits                    types.Int64    `tfsdk:"bits"`
+	Min                     types.Float64  `tfsdk:"min"`
+	Max                     types.Float64  `tfsdk:"max"`
+	WildcardProjection      types.String   `tfsdk:"wildcard_projection"`
+	ForceDestroy            types.Bool     `tfsdk:"force_destroy"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+// logFields returns the fields identifying the target of the operations in the logs.
+func (data *IndexResourceModel) logFields() []zap.Field {
+	return []zap.Field{
+		logging.String(logging.KeyDatabase, data.Database),
+		logging.String(logging.KeyCollection, data.Collection),
+		logging.String(logging.KeyID, data.Id),
+	}
 }
 
 func (r *IndexResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -57,8 +90,9 @@ func (r *IndexResource) Metadata(ctx context.Context, req resource.MetadataReque
 func (r *IndexResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: mdutils.FormatResourceDescription(`
-			This resource creates an index for single field in a collection 
-			in a database on the MongoDB server.
+			This resource creates an index for single field or compound keys
+			in a collection in a database on the MongoDB server, including
+			the text, geospatial and wildcard indexes.
 		`),
 
 		Attributes: map[string]schema.Attribute{
@@ -85,41 +119,117 @@ func (r *IndexResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"database": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Name of the database to create the collection in.",
+				Required: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(`
+					Name of the database to create the collection in.
+
+					The index follows its collection when the collection is moved into this database
+					with %s. Otherwise, changing it replaces the index.
+				`, mdutils.InlineCodeBlock("rename_in_place")),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					collection.RequiresReplaceUnlessFollowed(),
 				},
 			},
 			"collection": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Name of the collection to create the index in.",
+				Required: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(`
+					Name of the collection to create the index in.
+
+					The index follows its collection when the collection is renamed to this name
+					with %s. Otherwise, changing it replaces the index.
+				`, mdutils.InlineCodeBlock("rename_in_place")),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					collection.RequiresReplaceUnlessFollowed(),
 				},
 			},
 			"index_name": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "Name of the index.",
+				Computed: true,
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Name of the index. Defaults to the name derived from the keys, such as %s.
+						Indexes of the same keys differing only by %s must be named apart.
+					`,
+					mdutils.InlineCodeBlock("age_1"),
+					mdutils.InlineCodeBlock("partial_filter_expression"),
+				),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed: true,
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Ordered keys of the index, which is either a single field or a compound index.
+						Exactly one of %s and %s must be set.
+					`,
+					mdutils.InlineCodeBlock("field"),
+					mdutils.InlineCodeBlock("keys"),
+				),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the field to index.",
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+							Optional: true,
+							MarkdownDescription: mdutils.FormatSchemaDescription(
+								`
+									Type of the key, which is one of %s. Defaults to %s.
+								`,
+								mdutils.InlineCodeBlocks(mongoclient.IndexKeyTypes),
+								mdutils.InlineCodeBlock(mongoclient.IndexKeyAscending),
+							),
+							Default: stringdefault.StaticString(mongoclient.IndexKeyAscending),
+							Validators: []validator.String{
+								IsIndexKeyType(),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+					listplanmodifier.RequiresReplaceIf(
+						requiresReplaceUnlessEqualKeys,
+						"Changing the keys replaces the index, unless only the order of the text keys changes.",
+						"Changing the keys replaces the index, unless only the order of the text keys changes.",
+					),
 				},
 			},
 			"field": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Name of the field to create the index on.",
+				Computed: true,
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Name of the field to create the index on.
+						With %s, this is the field of the first key.
+					`,
+					mdutils.InlineCodeBlock("keys"),
+				),
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"direction": schema.Int64Attribute{
-				Computed:            true,
-				Optional:            true,
-				MarkdownDescription: "Direction of the index. 1 for ascending, -1 for descending.",
-				Default:             int64default.StaticInt64(1),
+				Computed: true,
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Direction of the index on %s. 1 for ascending, -1 for descending. Defaults to 1.
+						With %s, this is the direction of the first key, if the key has one.
+					`,
+					mdutils.InlineCodeBlock("field"),
+					mdutils.InlineCodeBlock("keys"),
+				),
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
 					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
 				},
 				Validators: []validator.Int64{
 					IsDirection(),
@@ -135,6 +245,191 @@ func (r *IndexResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"expire_after_seconds": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Makes the index a [TTL index](https://www.mongodb.com/docs/manual/core/index-ttl/),
+						which removes the documents this number of seconds after the date in the indexed field.
+						TTL indexes must have a single key, and documents whose field is not a date never expire.
+						Changing it is applied in place with the %s command,
+						while adding or removing it replaces the index.
+					`,
+					mdutils.InlineCodeBlock("collMod"),
+				),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						requiresReplaceIfExpirationToggled,
+						"Adding or removing the expiration replaces the index.",
+						"Adding or removing the expiration replaces the index.",
+					),
+				},
+			},
+			"partial_filter_expression": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Filter in extended JSON, which makes the index a [partial index](https://www.mongodb.com/docs/manual/core/index-partial/)
+						indexing only the documents matching the filter, for example %s.
+					`,
+					mdutils.InlineCodeBlock(`{"deleted_at": {"$exists": false}}`),
+				),
+				Validators: []validator.String{collection.IsEJSONDocument()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sparse": schema.BoolAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "If true, creates a sparse index, which skips the documents missing the indexed fields.",
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hidden": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						If true, hides the index from the query planner, while the index is still maintained.
+						Hiding and unhiding the index are applied in place with the %s command,
+						so that the impact of dropping the index can be evaluated.
+					`,
+					mdutils.InlineCodeBlock("collMod"),
+				),
+				Default: booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"weights": schema.MapAttribute{
+				Computed:    true,
+				Optional:    true,
+				ElementType: types.Int64Type,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Weights of the fields of the [text index](https://www.mongodb.com/docs/manual/core/indexes/index-types/index-text/),
+						between 1 and %d. The fields whose weights are not set have the weight of 1.
+					`,
+					MaxTextIndexWeight,
+				),
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+					mapplanmodifier.RequiresReplaceIf(
+						requiresReplaceUnlessEqualWeights,
+						"Changing the weights replaces the index, unless only the default weights are added or removed.",
+						"Changing the weights replaces the index, unless only the default weights are added or removed.",
+					),
+				},
+			},
+			"default_language": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Language of the text index, which determines the stop words and the stemming.
+						Defaults to %s.
+					`,
+					mdutils.InlineCodeBlock("english"),
+				),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"language_override": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Name of the field of the documents holding their language for the text index.
+						Defaults to %s.
+					`,
+					mdutils.InlineCodeBlock("language"),
+				),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"text_index_version": schema.Int64Attribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Version of the text index. Defaults to the latest version supported by the server.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"sphere_index_version": schema.Int64Attribute{
+				Computed: true,
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Version of the [2dsphere index](https://www.mongodb.com/docs/manual/core/indexes/index-types/geospatial/2dsphere/),
+						set as %s on the server. Defaults to the latest version supported by the server.
+					`,
+					mdutils.InlineCodeBlock("2dsphereIndexVersion"),
+				),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"bits": schema.Int64Attribute{
+				Computed: true,
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Precision of the coordinates of the [2d index](https://www.mongodb.com/docs/manual/core/indexes/index-types/geospatial/2d/)
+						in bits, between 1 and %d. Defaults to 26.
+					`,
+					MaxGeoIndexBits,
+				),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"min": schema.Float64Attribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Lower bound of the coordinates of the 2d index. Defaults to -180.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"max": schema.Float64Attribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Upper bound of the coordinates of the 2d index, exclusive. Defaults to 180.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"wildcard_projection": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Projection in extended JSON selecting the fields indexed by the
+						[wildcard index](https://www.mongodb.com/docs/manual/core/indexes/index-types/index-wildcard/)
+						on all the fields, %s, for example %s. The wildcard key on the fields of a subdocument,
+						such as %s, indexes them without a projection.
+					`,
+					mdutils.InlineCodeBlock(mongoclient.WildcardField),
+					mdutils.InlineCodeBlock(`{"attrs": 1, "tags": 1}`),
+					mdutils.InlineCodeBlock("attrs.$**"),
+				),
+				Validators: []validator.String{collection.IsEJSONDocument()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"force_destroy": schema.BoolAttribute{
 				Computed: true,
 				Optional: true,
@@ -152,6 +447,15 @@ func (r *IndexResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -166,8 +470,22 @@ func (r *IndexResource) Configure(ctx context.Context, req resource.ConfigureReq
 }
 
 func (r *IndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data IndexResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "create", data.Timeouts.Create, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "create", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -175,14 +493,6 @@ func (r *IndexResource) Create(ctx context.Context, req resource.CreateRequest,
 			return
 		}
 
-		var data IndexResourceModel
-
-		// Read Terraform plan data into the model
-		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform create operation
 		resp.Diagnostics.Append(resourceCreate(client, &data)...)
 		if resp.Diagnostics.HasError() {
@@ -195,8 +505,22 @@ func (r *IndexResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *IndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+	var data IndexResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "read", data.Timeouts.Read, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "read", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -204,13 +528,6 @@ func (r *IndexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			return
 		}
 
-		var data IndexResourceModel
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Perform read operation
 		resp.Diagnostics.Append(resourceRead(client, &data)...)
 		if resp.Diagnostics.HasError() {
@@ -222,22 +539,68 @@ func (r *IndexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	})
 }
 
-func (r *IndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+func (r *IndexResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data IndexResourceModel
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(validateKeys(&data)...)
+	resp.Diagnostics.Append(validateExpiration(&data)...)
+	resp.Diagnostics.Append(validateText(&data)...)
+	resp.Diagnostics.Append(validateGeo(&data)...)
+	resp.Diagnostics.Append(validateWildcard(&data)...)
 }
 
-func (r *IndexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	client := mongoclient.New(ctx, r.config.ClientConfig).WithLogger(r.config.Logger)
-	client.Run(func(client *mongoclient.MongoClient, err error) {
+func (r *IndexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on creation and destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state IndexResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The identifier of the index which follows its collection is known after the rename
+	if moved(&plan, &state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	}
+}
+
+func (r *IndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IndexResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the attributes not applied to the server have changed,
+	// such as force_destroy or the order of the text keys
+	if !moved(&data, &state) && !modified(&data, &state) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "update", data.Timeouts.Update, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "update", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
 		if err != nil {
 			resp.Diagnostics.Append(
 				errs.NewMongoClientError(err).ToDiagnostic(),
@@ -245,14 +608,41 @@ func (r *IndexResource) Delete(ctx context.Context, req resource.DeleteRequest,
 			return
 		}
 
-		var data IndexResourceModel
-
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		// Perform update operation
+		resp.Diagnostics.Append(resourceUpdate(client, &data, &state)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	})
+}
+
+func (r *IndexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IndexResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "delete", data.Timeouts.Delete, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "delete", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
+		if err != nil {
+			resp.Diagnostics.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return
+		}
+
 		// Perform delete operation
 		resp.Diagnostics.Append(resourceDelete(client, &data)...)
 	})
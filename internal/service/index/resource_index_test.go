@@ -13,6 +13,7 @@ import (
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/acc"
 	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/mongolocal"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -43,6 +44,9 @@ func TestAccIndexResource_Lifecycle(t *testing.T) {
 						resource.TestCheckResourceAttr("mongodb_database_index.test", "collection", "test-collection"),
 						resource.TestCheckResourceAttr("mongodb_database_index.test", "field", "test-field"),
 						resource.TestCheckResourceAttr("mongodb_database_index.test", "direction", "1"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.#", "1"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.0.field", "test-field"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.0.type", "1"),
 						resource.TestCheckResourceAttr("mongodb_database_index.test", "unique", "false"),
 						resource.TestCheckResourceAttr("mongodb_database_index.test", "force_destroy", "false"),
 					),
@@ -154,6 +158,614 @@ func TestAccIndexResource_Variant(t *testing.T) {
 	})
 }
 
+func TestAccIndexResource_Compound(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		acc.PreTestAccIndexResource(server, logger)
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Both field and keys are rejected
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "test-field"
+							keys = [{ field = "test-field" }]
+						}
+					`, server.URI()),
+					ExpectError: regexp.MustCompile(errs.NewInvalidResourceConfiguration("").Name()),
+				},
+				// Create and Read testing
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							keys = [
+								{ field = "test-field" },
+								{ field = "other-field", type = "-1" },
+								{ field = "hashed-field", type = "hashed" },
+							]
+							force_destroy = true
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "index_name", "test-field_1_other-field_-1_hashed-field_hashed"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.#", "3"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.0.field", "test-field"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.0.type", "1"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.1.field", "other-field"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.1.type", "-1"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.2.field", "hashed-field"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.2.type", "hashed"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "field", "test-field"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "direction", "1"),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:            "mongodb_database_index.test",
+					ImportStateId:           "databases/test-database/collections/test-collection/indexes/test-field_1_other-field_-1_hashed-field_hashed",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy"},
+				},
+				// Reordering the keys replaces the index
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							keys = [
+								{ field = "other-field", type = "-1" },
+								{ field = "test-field" },
+							]
+							force_destroy = true
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_index.test", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "index_name", "other-field_-1_test-field_1"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "field", "other-field"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "direction", "-1"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccIndexResource_Expiration(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		acc.PreTestAccIndexResource(server, logger)
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Create and Read testing
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "expires-at"
+							expire_after_seconds = 3600
+							force_destroy = true
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "index_name", "expires-at_1"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "expire_after_seconds", "3600"),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:            "mongodb_database_index.test",
+					ImportStateId:           "databases/test-database/collections/test-collection/indexes/expires-at_1",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy"},
+				},
+				// Changing the expiration is applied in place
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "expires-at"
+							expire_after_seconds = 7200
+							force_destroy = true
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_index.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "expire_after_seconds", "7200"),
+					),
+				},
+				// Removing the expiration replaces the index
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "expires-at"
+							force_destroy = true
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_index.test", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr("mongodb_database_index.test", "expire_after_seconds"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccIndexResource_Partial(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		acc.PreTestAccIndexResource(server, logger)
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Create and Read testing
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "active" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "email"
+							unique = true
+							partial_filter_expression = jsonencode({ deleted_at = { "$exists" = false } })
+							force_destroy = true
+						}
+
+						resource "mongodb_database_index" "verified" {
+							database = "test-database"
+							collection = "test-collection"
+							index_name = "email_1_verified"
+							field = "email"
+							unique = true
+							partial_filter_expression = jsonencode({ verified = true })
+							force_destroy = true
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.active", "index_name", "email_1"),
+						resource.TestCheckResourceAttr("mongodb_database_index.active", "partial_filter_expression", `{"deleted_at":{"$exists":false}}`),
+						resource.TestCheckResourceAttr("mongodb_database_index.verified", "index_name", "email_1_verified"),
+						resource.TestCheckResourceAttr("mongodb_database_index.verified", "partial_filter_expression", `{"verified":true}`),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:            "mongodb_database_index.verified",
+					ImportStateId:           "databases/test-database/collections/test-collection/indexes/email_1_verified",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy"},
+				},
+				// Changing the filter replaces the index
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "active" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "email"
+							unique = true
+							partial_filter_expression = jsonencode({ deleted_at = null })
+							force_destroy = true
+						}
+
+						resource "mongodb_database_index" "verified" {
+							database = "test-database"
+							collection = "test-collection"
+							index_name = "email_1_verified"
+							field = "email"
+							unique = true
+							partial_filter_expression = jsonencode({ verified = true })
+							force_destroy = true
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_index.active", plancheck.ResourceActionDestroyBeforeCreate),
+							plancheck.ExpectResourceAction("mongodb_database_index.verified", plancheck.ResourceActionNoop),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.active", "partial_filter_expression", `{"deleted_at":null}`),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccIndexResource_Hidden(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		acc.PreTestAccIndexResource(server, logger)
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Create and Read testing
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "test-field"
+							sparse = true
+							hidden = false
+							force_destroy = true
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "sparse", "true"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "hidden", "false"),
+					),
+				},
+				// Hiding the index is applied in place
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "test-field"
+							sparse = true
+							hidden = true
+							force_destroy = true
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_index.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "hidden", "true"),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:            "mongodb_database_index.test",
+					ImportStateId:           "databases/test-database/collections/test-collection/indexes/test-field_1",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy"},
+				},
+				// Unhiding the index is applied in place
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "test-field"
+							sparse = true
+							hidden = false
+							force_destroy = true
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_index.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "hidden", "false"),
+					),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccIndexResource_Text(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		acc.PreTestAccIndexResource(server, logger)
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Text options are rejected for the other indexes
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "test-field"
+							default_language = "spanish"
+						}
+					`, server.URI()),
+					ExpectError: regexp.MustCompile(errs.NewInvalidResourceConfiguration("").Name()),
+				},
+				// Create and Read testing
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							keys = [
+								{ field = "body", type = "text" },
+								{ field = "title", type = "text" },
+							]
+							weights = {
+								body = 1
+								title = 10
+							}
+							default_language = "spanish"
+							language_override = "idioma"
+							force_destroy = true
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "index_name", "body_text_title_text"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.#", "2"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.0.field", "body"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "keys.1.field", "title"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "weights.title", "10"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "default_language", "spanish"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "language_override", "idioma"),
+						resource.TestCheckResourceAttr("mongodb_database_index.test", "text_index_version", "3"),
+						resource.TestCheckNoResourceAttr("mongodb_database_index.test", "direction"),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:            "mongodb_database_index.test",
+					ImportStateId:           "databases/test-database/collections/test-collection/indexes/body_text_title_text",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy"},
+				},
+				// The default weights and the order of the text keys are not reported as drift
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							keys = [
+								{ field = "title", type = "text" },
+								{ field = "body", type = "text" },
+							]
+							weights = {
+								title = 10
+							}
+							default_language = "spanish"
+							language_override = "idioma"
+							force_destroy = true
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PostApplyPostRefresh: []plancheck.PlanCheck{
+							plancheck.ExpectEmptyPlan(),
+						},
+					},
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccIndexResource_Geo(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		acc.PreTestAccIndexResource(server, logger)
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// 2d keys must be the first keys
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							keys = [
+								{ field = "category", type = "1" },
+								{ field = "position", type = "2d" },
+							]
+						}
+					`, server.URI()),
+					ExpectError: regexp.MustCompile(errs.NewInvalidResourceConfiguration("").Name()),
+				},
+				// 2d options are rejected for the 2dsphere indexes
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							keys = [{ field = "location", type = "2dsphere" }]
+							bits = 20
+						}
+					`, server.URI()),
+					ExpectError: regexp.MustCompile(errs.NewInvalidResourceConfiguration("").Name()),
+				},
+				// Create and Read testing
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "sphere" {
+							database = "test-database"
+							collection = "test-collection"
+							keys = [
+								{ field = "location", type = "2dsphere" },
+								{ field = "category", type = "1" },
+							]
+							force_destroy = true
+						}
+
+						resource "mongodb_database_index" "planar" {
+							database = "test-database"
+							collection = "test-collection"
+							keys = [{ field = "position", type = "2d" }]
+							bits = 20
+							min = -90
+							max = 90
+							force_destroy = true
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.sphere", "index_name", "location_2dsphere_category_1"),
+						resource.TestCheckResourceAttr("mongodb_database_index.sphere", "field", "location"),
+						resource.TestCheckNoResourceAttr("mongodb_database_index.sphere", "direction"),
+						resource.TestCheckResourceAttr("mongodb_database_index.sphere", "sphere_index_version", "3"),
+						resource.TestCheckNoResourceAttr("mongodb_database_index.sphere", "bits"),
+						resource.TestCheckResourceAttr("mongodb_database_index.planar", "index_name", "position_2d"),
+						resource.TestCheckResourceAttr("mongodb_database_index.planar", "bits", "20"),
+						resource.TestCheckResourceAttr("mongodb_database_index.planar", "min", "-90"),
+						resource.TestCheckResourceAttr("mongodb_database_index.planar", "max", "90"),
+						resource.TestCheckNoResourceAttr("mongodb_database_index.planar", "sphere_index_version"),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:            "mongodb_database_index.sphere",
+					ImportStateId:           "databases/test-database/collections/test-collection/indexes/location_2dsphere_category_1",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy"},
+				},
+				{
+					ResourceName:            "mongodb_database_index.planar",
+					ImportStateId:           "databases/test-database/collections/test-collection/indexes/position_2d",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy"},
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
+func TestAccIndexResource_Wildcard(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		acc.PreTestAccIndexResource(server, logger)
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// The projection is rejected for the wildcard keys on a subdocument
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "test" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "attrs.$**"
+							wildcard_projection = jsonencode({ "attrs.color" = 1 })
+						}
+					`, server.URI()),
+					ExpectError: regexp.MustCompile(errs.NewInvalidResourceConfiguration("").Name()),
+				},
+				// Create and Read testing
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_index" "subdocument" {
+							database = "test-database"
+							collection = "test-collection"
+							field = "attrs.$**"
+							force_destroy = true
+						}
+
+						resource "mongodb_database_index" "projected" {
+							database = "test-database"
+							collection = "test-collection"
+							keys = [{ field = "$**" }]
+							wildcard_projection = jsonencode({ tags = 1, labels = 1 })
+							force_destroy = true
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_index.subdocument", "index_name", "attrs.$**_1"),
+						resource.TestCheckResourceAttr("mongodb_database_index.subdocument", "keys.0.field", "attrs.$**"),
+						resource.TestCheckNoResourceAttr("mongodb_database_index.subdocument", "wildcard_projection"),
+						resource.TestCheckResourceAttr("mongodb_database_index.projected", "index_name", "$**_1"),
+						resource.TestCheckResourceAttr("mongodb_database_index.projected", "wildcard_projection", `{"labels":1,"tags":1}`),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:            "mongodb_database_index.subdocument",
+					ImportStateId:           "databases/test-database/collections/test-collection/indexes/attrs.$**_1",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy"},
+				},
+				{
+					ResourceName:            "mongodb_database_index.projected",
+					ImportStateId:           "databases/test-database/collections/test-collection/indexes/$**_1",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"force_destroy"},
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
+
 func TestAccIndexResource_ForceDestroy(t *testing.T) {
 	t.Parallel()
 	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
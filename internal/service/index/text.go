@@ -0,0 +1,190 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package index
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// MaxTextIndexWeight is the largest weight of a field of the text index.
+const MaxTextIndexWeight = 99999
+
+// TextIndexVersions are the versions of the text index supported by the server.
+var TextIndexVersions = []int64{1, 2, 3}
+
+// textIndexOptions sets the options of the text index in the resource to the options.
+func textIndexOptions(data *IndexResourceModel, opts *mongoclient.IndexOptions) {
+	if !data.Weights.IsNull() && !data.Weights.IsUnknown() {
+		opts.Weights = make(map[string]int32, len(data.Weights.Elements()))
+		for field, element := range data.Weights.Elements() {
+			if weight, ok := element.(basetypes.Int64Value); ok {
+				opts.Weights[field] = int32(weight.ValueInt64())
+			}
+		}
+	}
+	if !data.DefaultLanguage.IsUnknown() {
+		opts.DefaultLanguage = data.DefaultLanguage.ValueString()
+	}
+	if !data.LanguageOverride.IsUnknown() {
+		opts.LanguageOverride = data.LanguageOverride.ValueString()
+	}
+	if !data.TextIndexVersion.IsUnknown() {
+		opts.TextIndexVersion = int32(data.TextIndexVersion.ValueInt64())
+	}
+}
+
+// readTextIndexOptions sets the options of the text index into the data source,
+// which are unset if the index is not a text index.
+func readTextIndexOptions(opts mongoclient.IndexOptions, data *IndexDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case opts.Weights == nil:
+		data.Weights = basetypes.NewMapNull(types.Int64Type)
+	case equalWeights(data.Weights, opts.Weights):
+		// The weights in the data are kept, as the server reports
+		// the weights of all the fields including the default ones
+	default:
+		weights := make(map[string]attr.Value, len(opts.Weights))
+		for field, weight := range opts.Weights {
+			weights[field] = basetypes.NewInt64Value(int64(weight))
+		}
+		value, d := basetypes.NewMapValue(types.Int64Type, weights)
+		diags.Append(d...)
+		data.Weights = value
+	}
+
+	data.DefaultLanguage = basetypes.NewStringNull()
+	if opts.DefaultLanguage != "" {
+		data.DefaultLanguage = basetypes.NewStringValue(opts.DefaultLanguage)
+	}
+	data.LanguageOverride = basetypes.NewStringNull()
+	if opts.LanguageOverride != "" {
+		data.LanguageOverride = basetypes.NewStringValue(opts.LanguageOverride)
+	}
+	data.TextIndexVersion = basetypes.NewInt64Null()
+	if opts.TextIndexVersion != 0 {
+		data.TextIndexVersion = basetypes.NewInt64Value(int64(opts.TextIndexVersion))
+	}
+	return diags
+}
+
+// equalWeights reports whether the weights in the map are the weights of the text index,
+// where the fields missing in the map have the weight of 1.
+func equalWeights(value basetypes.MapValue, weights map[string]int32) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return false
+	}
+
+	elements := value.Elements()
+	for field := range elements {
+		if _, ok := weights[field]; !ok {
+			return false
+		}
+	}
+	for field, weight := range weights {
+		configured := int64(1)
+		if element, ok := elements[field].(basetypes.Int64Value); ok {
+			configured = element.ValueInt64()
+		}
+		if configured != int64(weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// requiresReplaceUnlessEqualWeights replaces the index when the weights change,
+// unless only the weights of 1 which the server reports for all the fields are added or removed.
+func requiresReplaceUnlessEqualWeights(ctx context.Context, req planmodifier.MapRequest, resp *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.PlanValue.IsUnknown() || req.PlanValue.IsNull() || req.StateValue.IsNull() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	current := make(map[string]int32, len(req.StateValue.Elements()))
+	for field, element := range req.StateValue.Elements() {
+		if weight, ok := element.(basetypes.Int64Value); ok {
+			current[field] = int32(weight.ValueInt64())
+		}
+	}
+	planned := make(map[string]int32, len(req.PlanValue.Elements()))
+	for field, element := range req.PlanValue.Elements() {
+		if weight, ok := element.(basetypes.Int64Value); ok {
+			planned[field] = int32(weight.ValueInt64())
+		}
+	}
+	resp.RequiresReplace = !equalWeights(req.PlanValue, current) || !equalWeights(req.StateValue, planned)
+}
+
+// validateText checks the options of the text index are set only for the text keys.
+func validateText(data *IndexResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Keys.IsUnknown() {
+		return diags
+	}
+	keys, d := configuredKeys(data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var fields []string
+	for _, key := range keys {
+		if key.Type == mongoclient.IndexKeyText {
+			fields = append(fields, key.Field)
+		}
+	}
+
+	if len(fields) == 0 {
+		options := map[string]attr.Value{
+			"weights":            data.Weights,
+			"default_language":   data.DefaultLanguage,
+			"language_override":  data.LanguageOverride,
+			"text_index_version": data.TextIndexVersion,
+		}
+		for _, attribute := range []string{"weights", "default_language", "language_override", "text_index_version"} {
+			if !options[attribute].IsNull() {
+				diags.Append(
+					errs.NewInvalidResourceConfiguration(fmt.Sprintf("%s can be set only for text indexes", attribute)).ToDiagnostic(),
+				)
+			}
+		}
+		return diags
+	}
+
+	if !data.Weights.IsNull() && !data.Weights.IsUnknown() {
+		for field, element := range data.Weights.Elements() {
+			if !slices.Contains(fields, field) {
+				diags.Append(
+					errs.NewInvalidResourceConfiguration(fmt.Sprintf("weights must be set only for the text keys, but %s is not a text key", field)).ToDiagnostic(),
+				)
+			}
+			weight, ok := element.(basetypes.Int64Value)
+			if ok && !weight.IsUnknown() && (weight.ValueInt64() < 1 || weight.ValueInt64() > MaxTextIndexWeight) {
+				diags.Append(
+					errs.NewInvalidResourceConfiguration(fmt.Sprintf("the weight of %s must be between 1 and %d", field, MaxTextIndexWeight)).ToDiagnostic(),
+				)
+			}
+		}
+	}
+	if !data.TextIndexVersion.IsNull() && !data.TextIndexVersion.IsUnknown() && !slices.Contains(TextIndexVersions, data.TextIndexVersion.ValueInt64()) {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration(fmt.Sprintf("text_index_version must be one of %v", TextIndexVersions)).ToDiagnostic(),
+		)
+	}
+	return diags
+}
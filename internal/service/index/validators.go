@@ -5,13 +5,19 @@ package index
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
 	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 const description = "direction must be 1 or -1"
 
+var keyTypeDescription = fmt.Sprintf("key type must be one of %s", strings.Join(mongoclient.IndexKeyTypes, ", "))
+
 type isDirection struct {
 	validator.Int64
 }
@@ -42,3 +48,33 @@ func (v *isDirection) ValidateInt64(ctx context.Context, req validator.Int64Requ
 		errs.NewInvalidInputValue(description).ToDiagnostic(),
 	)
 }
+
+type isIndexKeyType struct {
+	validator.String
+}
+
+func IsIndexKeyType() validator.String {
+	return &isIndexKeyType{}
+}
+
+func (v *isIndexKeyType) Description(context.Context) string {
+	return keyTypeDescription
+}
+
+func (v *isIndexKeyType) MarkdownDescription(context.Context) string {
+	return keyTypeDescription
+}
+
+func (v *isIndexKeyType) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(mongoclient.IndexKeyTypes, req.ConfigValue.ValueString()) {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(keyTypeDescription).ToDiagnostic(),
+	)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package index
+
+import (
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// validateWildcard checks the wildcard keys and their projection. An index has
+// at most one wildcard key, whose fields are selected by the projection
+// only if it indexes all the fields.
+func validateWildcard(data *IndexResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Keys.IsUnknown() || data.Field.IsUnknown() {
+		return diags
+	}
+	keys, d := configuredKeys(data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var wildcards []mongoclient.IndexKey
+	for _, key := range keys {
+		if mongoclient.IsWildcardKey(key) && key.Type != mongoclient.IndexKeyText {
+			wildcards = append(wildcards, key)
+		}
+	}
+
+	if len(wildcards) == 0 {
+		if !data.WildcardProjection.IsNull() {
+			diags.Append(
+				errs.NewInvalidResourceConfiguration("wildcard_projection can be set only for wildcard indexes").ToDiagnostic(),
+			)
+		}
+		return diags
+	}
+	if len(wildcards) > 1 {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("an index can have only one wildcard key").ToDiagnostic(),
+		)
+	}
+	for _, key := range wildcards {
+		if key.Type != mongoclient.IndexKeyAscending && key.Type != mongoclient.IndexKeyDescending {
+			diags.Append(
+				errs.NewInvalidResourceConfiguration("wildcard keys must be 1 or -1").ToDiagnostic(),
+			)
+		}
+	}
+	if !data.WildcardProjection.IsNull() && wildcards[0].Field != mongoclient.WildcardField {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("wildcard_projection can be set only for the wildcard key on all the fields, $**").ToDiagnostic(),
+		)
+	}
+	if data.Unique.ValueBool() {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("wildcard indexes cannot be unique").ToDiagnostic(),
+		)
+	}
+	if !data.ExpireAfterSeconds.IsNull() {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration("wildcard indexes cannot be TTL indexes").ToDiagnostic(),
+		)
+	}
+	return diags
+}
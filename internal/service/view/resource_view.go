@@ -0,0 +1,329 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"context"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/logging"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	resourcecollation "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/collation"
+	resourceconfig "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/config"
+	resourceid "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/id"
+	mdutils "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/string/markdown"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/zap"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ViewResource{}
+var _ resource.ResourceWithImportState = &ViewResource{}
+
+func NewViewResource() resource.Resource {
+	return &ViewResource{}
+}
+
+// ViewResource defines the resource implementation.
+type ViewResource struct {
+	config *resourceconfig.ResourceConfig
+}
+
+// ViewResourceModel describes the resource data model.
+type ViewResourceModel struct {
+	Id        types.String             `tfsdk:"id"`
+	Database  types.String             `tfsdk:"database"`
+	Name      types.String             `tfsdk:"name"`
+	ViewOn    types.String             `tfsdk:"view_on"`
+	Pipeline  types.String             `tfsdk:"pipeline"`
+	Collation *resourcecollation.Model `tfsdk:"collation"`
+	Timeouts  timeouts.Value           `tfsdk:"timeouts"`
+}
+
+// logFields returns the fields identifying the target of the operations in the logs.
+func (data *ViewResourceModel) logFields() []zap.Field {
+	return []zap.Field{
+		logging.String(logging.KeyDatabase, data.Database),
+		logging.String(logging.KeyCollection, data.Name),
+		logging.String(logging.KeyID, data.Id),
+	}
+}
+
+func (r *ViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_view"
+}
+
+func (r *ViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: mdutils.FormatResourceDescription(`
+			This resource creates a read-only view in a database on the MongoDB server.
+			The view serves the documents of a collection or another view
+			transformed by an aggregation pipeline.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Resource identifier.
+						
+						ID has a value with a format of the following:
+
+						%s
+
+						Note that this format is used for importing the resource into Terraform state.
+						Import the resource using the following command:
+
+						%s
+					`,
+					mdutils.CodeBlock("", "databases/<database>/collections/<name>"),
+					mdutils.CodeBlock("bash", "terraform import mongodb_database_view.<resource_name> databases/<database>/collections/<name>"),
+				),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the database to create the view in.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the view.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"view_on": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						Name of the collection or the view in the same database the view is defined on.
+						Changes are applied to the existing view with the %s command.
+					`,
+					mdutils.InlineCodeBlock("collMod"),
+				),
+			},
+			"pipeline": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: mdutils.FormatSchemaDescription(
+					`
+						[Aggregation pipeline](https://www.mongodb.com/docs/manual/core/aggregation-pipeline/)
+						applied to the documents of the source.
+
+						The value of this attribute is a stringified
+						[EJSON](https://www.mongodb.com/docs/manual/reference/mongodb-extended-json/#std-label-mongodb-extended-json-v2)
+						array of the stages, which you can write with the %s function:
+
+						%s
+
+						Changes are applied to the existing view with the %s command.
+					`,
+					mdutils.InlineCodeBlock("jsonencode"),
+					mdutils.CodeBlock("terraform", "pipeline = jsonencode([{ \"$project\" = { email = 0 } }])"),
+					mdutils.InlineCodeBlock("collMod"),
+				),
+				Validators: []validator.String{IsEJSONArray()},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"collation": resourcecollation.ResourceBlock(mdutils.FormatSchemaDescription(
+				`
+					Default [collation](https://www.mongodb.com/docs/manual/reference/collation/)
+					of the view, which the view does not inherit from its source.
+
+					Changing the collation replaces the view.
+				`,
+			)),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	config, diags := resourceconfig.FromProviderData(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config = config
+}
+
+func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ViewResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "create", data.Timeouts.Create, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "create", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
+		if err != nil {
+			resp.Diagnostics.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return
+		}
+
+		// Perform create operation
+		resp.Diagnostics.Append(resourceCreate(client, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	})
+}
+
+func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ViewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "read", data.Timeouts.Read, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "read", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
+		if err != nil {
+			resp.Diagnostics.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return
+		}
+
+		// Perform read operation
+		resp.Diagnostics.Append(resourceRead(client, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	})
+}
+
+func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ViewResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "update", data.Timeouts.Update, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "update", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
+		if err != nil {
+			resp.Diagnostics.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return
+		}
+
+		// Perform update operation
+		resp.Diagnostics.Append(resourceUpdate(client, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	})
+}
+
+func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ViewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bound the operation by the timeout
+	ctx, done := r.config.WithTimeout(ctx, "delete", data.Timeouts.Delete, &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.config.Run(ctx, "delete", data.logFields(), &resp.Diagnostics, func(client *mongoclient.MongoClient, err error) {
+		if err != nil {
+			resp.Diagnostics.Append(
+				errs.NewMongoClientError(err).ToDiagnostic(),
+			)
+			return
+		}
+
+		// Perform delete operation
+		resp.Diagnostics.Append(resourceDelete(client, &data)...)
+	})
+}
+
+func (r *ViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := resourceid.New(req.ID)
+	if err != nil {
+		resp.Diagnostics.Append(
+			errs.NewInvalidImportID(err.Error()).ToDiagnostic(),
+		)
+		return
+	}
+	if id.Database() == "" {
+		resp.Diagnostics.Append(
+			errs.NewInvalidImportID("Database name is required").ToDiagnostic(),
+		)
+		return
+	}
+	if id.Collection() == "" {
+		resp.Diagnostics.Append(
+			errs.NewInvalidImportID("View name is required").ToDiagnostic(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), id.Database())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), id.Collection())...)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package view_test
+
+import (
+	"regexp"
+	"testing"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/provider"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/acc"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/testutil/mongolocal"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccViewResource_Lifecycle(t *testing.T) {
+	t.Parallel()
+	mongolocal.RunWithServer(t, func(server *mongolocal.MongoLocal) {
+		logger := server.Logger()
+
+		mongoclient.FromURI(server.URI()).Run(func(client *mongoclient.MongoClient, err error) {
+			if err != nil {
+				logger.Sugar().Fatalf("failed to create a client: %v", err)
+			}
+
+			logger.Info("creating a collection to test the view resource")
+			if _, err := client.Database("test-database").Collection("test-collection").InsertOne(mongoclient.Document{"name": "test", "email": "test@example.com"}); err != nil {
+				logger.Sugar().Fatalf("failed to insert a document: %v", err)
+			}
+		})
+
+		logger.Info("running the test...")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acc.TestAccPreCheck(t) },
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactoriesWithProviderConfig(&provider.Config{Logger: logger}),
+			Steps: []resource.TestStep{
+				// Create and Read testing
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_view" "test" {
+							database = "test-database"
+							name = "test-view"
+							view_on = "test-collection"
+							pipeline = jsonencode([{ "$project" = { email = 0 } }])
+
+							collation {
+								locale = "en"
+							}
+						}
+					`, server.URI()),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_view.test", "id", "databases/test-database/collections/test-view"),
+						resource.TestCheckResourceAttr("mongodb_database_view.test", "view_on", "test-collection"),
+						resource.TestCheckResourceAttr("mongodb_database_view.test", "pipeline", `[{"$project":{"email":0}}]`),
+						resource.TestCheckResourceAttr("mongodb_database_view.test", "collation.locale", "en"),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:      "mongodb_database_view.test",
+					ImportStateId:     "databases/test-database/collections/test-view",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				// Update the pipeline in place
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_view" "test" {
+							database = "test-database"
+							name = "test-view"
+							view_on = "test-collection"
+							pipeline = jsonencode([{ "$project" = { email = 0 } }, { "$sort" = { name = 1 } }])
+
+							collation {
+								locale = "en"
+							}
+						}
+					`, server.URI()),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("mongodb_database_view.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mongodb_database_view.test", "pipeline", `[{"$project":{"email":0}},{"$sort":{"name":1}}]`),
+					),
+				},
+				// Views are not managed as collections
+				{
+					Config: acc.WithProviderConfig(`
+						resource "mongodb_database_collection" "test" {
+							database = "test-database"
+							name = "test-view"
+						}
+					`, server.URI()),
+					ResourceName:  "mongodb_database_collection.test",
+					ImportStateId: "databases/test-database/collections/test-view",
+					ImportState:   true,
+					ExpectError:   regexp.MustCompile(errs.NewCollectionTypeMismatch("test-view", "view", "collection").Name()),
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+	})
+}
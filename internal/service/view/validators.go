@@ -0,0 +1,46 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"context"
+
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	ejsonArrayDescription = "value must be an array in extended JSON"
+)
+
+type isEJSONArray struct {
+	validator.String
+}
+
+func IsEJSONArray() validator.String {
+	return &isEJSONArray{}
+}
+
+func (v *isEJSONArray) Description(context.Context) string {
+	return ejsonArrayDescription
+}
+
+func (v *isEJSONArray) MarkdownDescription(context.Context) string {
+	return ejsonArrayDescription
+}
+
+func (v *isEJSONArray) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := mongoclient.ParseEJSONArray(req.ConfigValue.ValueString()); err == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		errs.NewInvalidInputValue(ejsonArrayDescription).ToDiagnostic(),
+	)
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	errs "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/error"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/mongoclient"
+	resourcecollation "github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/common/resource/collation"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/service/collection"
+	"github.com/01Joseph-Hwang10/terraform-provider-mongodb/internal/service/database"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// viewOptions returns the options of the view in the resource data.
+func viewOptions(data *ViewResourceModel) (*mongoclient.ViewOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	pipeline, err := mongoclient.ParseEJSONArray(data.Pipeline.ValueString())
+	if err != nil {
+		diags.Append(
+			errs.NewEJsonParseError(err).ToDiagnostic(),
+		)
+		return nil, diags
+	}
+
+	return &mongoclient.ViewOptions{
+		ViewOn:    data.ViewOn.ValueString(),
+		Pipeline:  pipeline,
+		Collation: resourcecollation.ToCollation(data.Collation),
+	}, diags
+}
+
+// readViewOptions returns the options of the view, reporting an error
+// if the view does not exist or is a regular collection.
+func readViewOptions(view *mongoclient.Collection, diags *diag.Diagnostics) *mongoclient.CollectionOptions {
+	opts, err := view.Options()
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return nil
+	}
+	if opts == nil {
+		diags.Append(
+			errs.NewCollectionNotFound(view.Name()).ToDiagnostic(),
+		)
+		return nil
+	}
+	if opts.Type != mongoclient.CollectionTypeView {
+		diags.Append(
+			errs.NewCollectionTypeMismatch(view.Name(), opts.Type, mongoclient.CollectionTypeView).ToDiagnostic(),
+		)
+		return nil
+	}
+	return opts
+}
+
+func resourceRead(client *mongoclient.MongoClient, data *ViewResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Check if the database exists
+	database := database.CheckExistance(client, data.Database.ValueString(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Check if the view exists
+	opts := readViewOptions(database.Collection(data.Name.ValueString()), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Set resource Id
+	resourceId, err := collection.CreateResourceId(data.Database, data.Name)
+	if err != nil {
+		diags.Append(
+			errs.NewInvalidResourceConfiguration(err.Error()).ToDiagnostic(),
+		)
+		return diags
+	}
+	data.Id = resourceId
+
+	// The pipeline in the data is kept if it is equal to the pipeline of the view,
+	// so that its formatting is not reported as drift
+	pipeline, err := mongoclient.EJSONArrayString(opts.Pipeline)
+	if err != nil {
+		diags.Append(
+			errs.NewEJsonParseError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	if data.Pipeline.IsNull() || !mongoclient.EqualEJSON(data.Pipeline.ValueString(), pipeline) {
+		data.Pipeline = basetypes.NewStringValue(pipeline)
+	}
+	data.ViewOn = basetypes.NewStringValue(opts.ViewOn)
+	data.Collation = resourcecollation.FromCollation(opts.Collation)
+
+	return diags
+}
+
+func resourceCreate(client *mongoclient.MongoClient, data *ViewResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Check if the database exists
+	database := database.CheckExistance(client, data.Database.ValueString(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Validate view name
+	name := data.Name.ValueString()
+	if name == mongoclient.PlaceholderCollectionName {
+		diags.Append(
+			errs.NewInvalidCollectionName(name).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	// Regular collections of the name are not replaced by the view
+	existing, err := database.Collection(name).Options()
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	if existing != nil && existing.Type != mongoclient.CollectionTypeView {
+		diags.Append(
+			errs.NewCollectionTypeMismatch(name, existing.Type, mongoclient.CollectionTypeView).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	// Create the view with the options
+	opts, d := viewOptions(data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if err := database.CreateView(name, opts); err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	// Perform the read operation
+	diags.Append(resourceRead(client, data)...)
+
+	return diags
+}
+
+func resourceUpdate(client *mongoclient.MongoClient, data *ViewResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Check if the database exists
+	database := database.CheckExistance(client, data.Database.ValueString(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Check if the view exists
+	view := database.Collection(data.Name.ValueString())
+	readViewOptions(view, &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Apply the source and the pipeline to the view in place
+	opts, d := viewOptions(data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if err := view.ModifyView(opts); err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	// Perform the read operation
+	diags.Append(resourceRead(client, data)...)
+
+	return diags
+}
+
+func resourceDelete(client *mongoclient.MongoClient, data *ViewResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Check if the database exists
+	database := client.Database(data.Database.ValueString())
+	exists, err := database.Exists()
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	if !exists {
+		// We don't need to check if the view exists,
+		// as the database doesn't exist
+		return diags
+	}
+
+	// Check if the view exists
+	view := database.Collection(data.Name.ValueString())
+	opts, err := view.Options()
+	if err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+	if opts == nil {
+		// View doesn't exist, nothing to delete
+		return diags
+	}
+	if opts.Type != mongoclient.CollectionTypeView {
+		diags.Append(
+			errs.NewCollectionTypeMismatch(view.Name(), opts.Type, mongoclient.CollectionTypeView).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	// Dropping a view leaves the documents of its source intact
+	if err := view.Drop(); err != nil {
+		diags.Append(
+			errs.NewMongoClientError(err).ToDiagnostic(),
+		)
+		return diags
+	}
+
+	return diags
+}
@@ -36,6 +36,14 @@ func TestAccProtoV6ProviderFactoriesWithProviderConfig(config *provider.Config)
 }
 
 func ProviderConfig(uri string) string {
+	return CustomProviderConfig(fmt.Sprintf(`
+		uri = "%s"
+	`, uri))
+}
+
+// CustomProviderConfig returns the provider configuration
+// with the given body of the provider block.
+func CustomProviderConfig(body string) string {
 	return fmt.Sprintf(`
 		terraform {
 			required_providers {
@@ -46,9 +54,9 @@ func ProviderConfig(uri string) string {
 		}
 
 		provider "mongodb" {
-			uri = "%s"
+			%s
 		}	
-	`, uri)
+	`, body)
 }
 
 func WithProviderConfig(config string, uri string) string {
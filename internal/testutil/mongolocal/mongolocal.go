@@ -21,6 +21,17 @@ import (
 type Config struct {
 	DbPath string
 	Port   int
+
+	// TLS requires clients to connect with TLS if set.
+	TLS *TLSConfig
+}
+
+type TLSConfig struct {
+	// CertificateKeyFile contains both the server certificate and its key.
+	CertificateKeyFile string
+
+	// CAFile contains the CA to verify client certificates with.
+	CAFile string
 }
 
 func RandomPort() int {
@@ -56,7 +67,15 @@ type MongoLocal struct {
 
 func New(t *testing.T, config Config) (*MongoLocal, error) {
 	// Create a command to start MongoDB.
-	cmd := exec.Command("mongod", "--dbpath", config.DbPath, "--port", fmt.Sprintf("%d", config.Port))
+	args := []string{"--dbpath", config.DbPath, "--port", fmt.Sprintf("%d", config.Port)}
+	if config.TLS != nil {
+		args = append(args,
+			"--tlsMode", "requireTLS",
+			"--tlsCertificateKeyFile", config.TLS.CertificateKeyFile,
+			"--tlsCAFile", config.TLS.CAFile,
+		)
+	}
+	cmd := exec.Command("mongod", args...)
 
 	// Create a logger.
 	level := zap.InfoLevel
@@ -73,8 +92,12 @@ func New(t *testing.T, config Config) (*MongoLocal, error) {
 }
 
 func RunWithServer(t *testing.T, callback func(*MongoLocal)) {
+	RunWithServerConfig(t, DefaultConfig(), callback)
+}
+
+func RunWithServerConfig(t *testing.T, config Config, callback func(*MongoLocal)) {
 	// Create a new MongoDB server.
-	server, err := New(t, DefaultConfig())
+	server, err := New(t, config)
 	logger := server.logger
 	logger.Info("Starting MongoDB server...")
 	if err != nil {
@@ -169,7 +192,7 @@ func (m *MongoLocal) Stop() error {
 			m.logger.Warn("Failed to wait for MongoDB process", zap.Error(err))
 		}
 	}
-	if m.watcher.Process != nil {
+	if m.watcher != nil && m.watcher.Process != nil {
 		if err := m.watcher.Process.Kill(); err != nil {
 			m.logger.Warn("Failed to kill MongoDB watcher process", zap.Error(err))
 		}
@@ -0,0 +1,361 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package proxyserver
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// Kinds of proxy the server can act as.
+const (
+	SOCKS5 = "socks5"
+	HTTP   = "http"
+)
+
+// Server is an in-process proxy which forwards connections
+// to the requested addresses, either with SOCKS5 (without the
+// BIND and UDP ASSOCIATE commands) or with HTTP CONNECT.
+//
+// If a username is given, the server requires the client
+// to authenticate with it and the password.
+type Server struct {
+	kind     string
+	username string
+	password string
+
+	listener  net.Listener
+	mu        sync.Mutex
+	forwarded []string
+	conns     map[net.Conn]struct{}
+	wg        sync.WaitGroup
+}
+
+// Start starts serving on a random port of the loopback interface.
+func Start(kind, username, password string) (*Server, error) {
+	if kind != SOCKS5 && kind != HTTP {
+		return nil, fmt.Errorf("unsupported kind of proxy %q", kind)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		kind:     kind,
+		username: username,
+		password: password,
+		listener: listener,
+		conns:    map[net.Conn]struct{}{},
+	}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// URL returns the URL of the proxy, including the credentials if any.
+func (s *Server) URL() string {
+	u := &url.URL{
+		Scheme: s.kind,
+		Host:   s.listener.Addr().String(),
+	}
+	if s.username != "" {
+		u.User = url.UserPassword(s.username, s.password)
+	}
+	return u.String()
+}
+
+// Forwarded returns the addresses dialed through the proxy so far.
+func (s *Server) Forwarded() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.forwarded...)
+}
+
+// Close stops the server and closes every connection.
+func (s *Server) Close() error {
+	err := s.listener.Close()
+
+	s.mu.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.track(conn, true)
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer s.track(conn, false)
+
+			target, reader, err := s.handshake(conn)
+			if err != nil {
+				return
+			}
+			s.track(target, true)
+			defer s.track(target, false)
+
+			pipe(conn, reader, target)
+		}()
+	}
+}
+
+// handshake reads the request of the client and dials the requested address.
+// The returned reader yields the data sent by the client after the request.
+func (s *Server) handshake(conn net.Conn) (net.Conn, io.Reader, error) {
+	if s.kind == SOCKS5 {
+		return s.handshakeSOCKS5(conn)
+	}
+	return s.handshakeHTTP(conn)
+}
+
+func (s *Server) track(conn net.Conn, add bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if add {
+		s.conns[conn] = struct{}{}
+	} else {
+		delete(s.conns, conn)
+		conn.Close()
+	}
+}
+
+func (s *Server) dial(address string) (net.Conn, error) {
+	target, err := net.Dial("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.forwarded = append(s.forwarded, address)
+	s.mu.Unlock()
+
+	return target, nil
+}
+
+// SOCKS5 constants. (RFC 1928, RFC 1929)
+const (
+	socksVersion                 = 0x05
+	socksAuthNone                = 0x00
+	socksAuthUsernamePassword    = 0x02
+	socksAuthNoAcceptable        = 0xff
+	socksCommandConnect          = 0x01
+	socksAddressIPv4             = 0x01
+	socksAddressDomain           = 0x03
+	socksAddressIPv6             = 0x04
+	socksReplySucceeded          = 0x00
+	socksReplyFailure            = 0x01
+	socksReplyCommandUnsupported = 0x07
+)
+
+func (s *Server) handshakeSOCKS5(conn net.Conn) (net.Conn, io.Reader, error) {
+	reader := bufio.NewReader(conn)
+
+	// Negotiate the authentication method
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, nil, err
+	}
+	if header[0] != socksVersion {
+		return nil, nil, fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(reader, methods); err != nil {
+		return nil, nil, err
+	}
+
+	method := byte(socksAuthNone)
+	if s.username != "" {
+		method = socksAuthUsernamePassword
+	}
+	accepted := false
+	for _, m := range methods {
+		accepted = accepted || m == method
+	}
+	if !accepted {
+		_, _ = conn.Write([]byte{socksVersion, socksAuthNoAcceptable})
+		return nil, nil, errors.New("no acceptable authentication method")
+	}
+	if _, err := conn.Write([]byte{socksVersion, method}); err != nil {
+		return nil, nil, err
+	}
+
+	if method == socksAuthUsernamePassword {
+		username, password, err := readSOCKS5Credentials(reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		if username != s.username || password != s.password {
+			_, _ = conn.Write([]byte{0x01, 0x01})
+			return nil, nil, errors.New("invalid credentials")
+		}
+		if _, err := conn.Write([]byte{0x01, 0x00}); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Read the request
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(reader, request); err != nil {
+		return nil, nil, err
+	}
+	if request[1] != socksCommandConnect {
+		_, _ = conn.Write(socksReply(socksReplyCommandUnsupported))
+		return nil, nil, fmt.Errorf("unsupported SOCKS command %d", request[1])
+	}
+
+	var host string
+	switch request[3] {
+	case socksAddressIPv4, socksAddressIPv6:
+		size := net.IPv4len
+		if request[3] == socksAddressIPv6 {
+			size = net.IPv6len
+		}
+		ip := make([]byte, size)
+		if _, err := io.ReadFull(reader, ip); err != nil {
+			return nil, nil, err
+		}
+		host = net.IP(ip).String()
+	case socksAddressDomain:
+		length, err := reader.ReadByte()
+		if err != nil {
+			return nil, nil, err
+		}
+		domain := make([]byte, length)
+		if _, err := io.ReadFull(reader, domain); err != nil {
+			return nil, nil, err
+		}
+		host = string(domain)
+	default:
+		return nil, nil, fmt.Errorf("unsupported SOCKS address type %d", request[3])
+	}
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(reader, port); err != nil {
+		return nil, nil, err
+	}
+
+	address := net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port))))
+	target, err := s.dial(address)
+	if err != nil {
+		_, _ = conn.Write(socksReply(socksReplyFailure))
+		return nil, nil, err
+	}
+	if _, err := conn.Write(socksReply(socksReplySucceeded)); err != nil {
+		target.Close()
+		return nil, nil, err
+	}
+
+	return target, reader, nil
+}
+
+func readSOCKS5Credentials(reader *bufio.Reader) (string, string, error) {
+	version, err := reader.ReadByte()
+	if err != nil {
+		return "", "", err
+	}
+	if version != 0x01 {
+		return "", "", fmt.Errorf("unsupported authentication version %d", version)
+	}
+
+	var values [2]string
+	for i := range values {
+		length, err := reader.ReadByte()
+		if err != nil {
+			return "", "", err
+		}
+		value := make([]byte, length)
+		if _, err := io.ReadFull(reader, value); err != nil {
+			return "", "", err
+		}
+		values[i] = string(value)
+	}
+	return values[0], values[1], nil
+}
+
+// socksReply returns a reply with an unspecified bound address.
+func socksReply(code byte) []byte {
+	return []byte{socksVersion, code, 0x00, socksAddressIPv4, 0, 0, 0, 0, 0, 0}
+}
+
+func (s *Server) handshakeHTTP(conn net.Conn) (net.Conn, io.Reader, error) {
+	reader := bufio.NewReader(conn)
+
+	req, err := http.ReadRequest(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Body.Close()
+
+	if req.Method != http.MethodConnect {
+		_, _ = io.WriteString(conn, "HTTP/1.1 405 Method Not Allowed\r\n\r\n")
+		return nil, nil, fmt.Errorf("unsupported method %s", req.Method)
+	}
+	if s.username != "" {
+		username, password, ok := parseProxyAuthorization(req)
+		if !ok || username != s.username || password != s.password {
+			_, _ = io.WriteString(conn, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+			return nil, nil, errors.New("invalid credentials")
+		}
+	}
+
+	target, err := s.dial(req.Host)
+	if err != nil {
+		_, _ = io.WriteString(conn, "HTTP/1.1 502 Bad Gateway\r\n\r\n")
+		return nil, nil, err
+	}
+	if _, err := io.WriteString(conn, "HTTP/1.1 200 Connection Established\r\n\r\n"); err != nil {
+		target.Close()
+		return nil, nil, err
+	}
+
+	return target, reader, nil
+}
+
+func parseProxyAuthorization(req *http.Request) (string, string, bool) {
+	// Reuse the parser of the Authorization header
+	header := http.Header{}
+	header.Set("Authorization", req.Header.Get("Proxy-Authorization"))
+	return (&http.Request{Header: header}).BasicAuth()
+}
+
+// pipe copies data in both directions until either side is closed.
+// The data already buffered from the client is read from the reader.
+func pipe(client net.Conn, reader io.Reader, target net.Conn) {
+	var once sync.Once
+	closeBoth := func() {
+		client.Close()
+		target.Close()
+	}
+
+	go func() {
+		_, _ = io.Copy(client, target)
+		once.Do(closeBoth)
+	}()
+	_, _ = io.Copy(target, reader)
+	once.Do(closeBoth)
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package sshserver
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// User accepted by the server.
+const User = "tunnel"
+
+// Password used to encrypt the client key.
+const ClientKeyPassword = "test-password"
+
+// Server is an in-process SSH server which only accepts
+// public key authentication of User and only serves
+// "direct-tcpip" channels, i.e. local port forwarding.
+type Server struct {
+	Host string
+	Port int
+
+	// ClientKeyFile contains the private key of User.
+	ClientKeyFile string
+
+	// EncryptedClientKeyFile contains the same key
+	// encrypted with ClientKeyPassword.
+	EncryptedClientKeyFile string
+
+	// KnownHostsFile contains the host key of the server.
+	KnownHostsFile string
+
+	listener  net.Listener
+	config    *ssh.ServerConfig
+	mu        sync.Mutex
+	forwarded []string
+	conns     map[net.Conn]struct{}
+	wg        sync.WaitGroup
+}
+
+// Start generates the keys into the directory
+// and starts serving on a random port of the loopback interface.
+func Start(dir string) (*Server, error) {
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		return nil, err
+	}
+
+	clientPublicKey, clientKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	authorizedKey, err := ssh.NewPublicKey(clientPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	address, ok := listener.Addr().(*net.TCPAddr)
+	if !ok {
+		listener.Close()
+		return nil, errors.New("failed to get the address of the listener")
+	}
+
+	s := &Server{
+		Host:                   address.IP.String(),
+		Port:                   address.Port,
+		ClientKeyFile:          filepath.Join(dir, "ssh-client.key"),
+		EncryptedClientKeyFile: filepath.Join(dir, "ssh-client-encrypted.key"),
+		KnownHostsFile:         filepath.Join(dir, "known_hosts"),
+		listener:               listener,
+		conns:                  map[net.Conn]struct{}{},
+	}
+
+	// Write the client keys and the known hosts
+	plain, err := ssh.MarshalPrivateKey(clientKey, "")
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+	encrypted, err := ssh.MarshalPrivateKeyWithPassphrase(clientKey, "", []byte(ClientKeyPassword))
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+	knownHostsLine := knownhosts.Line(
+		[]string{knownhosts.Normalize(s.Address())},
+		hostSigner.PublicKey(),
+	)
+	files := map[string][]byte{
+		s.ClientKeyFile:          pem.EncodeToMemory(plain),
+		s.EncryptedClientKeyFile: pem.EncodeToMemory(encrypted),
+		s.KnownHostsFile:         []byte(knownHostsLine + "\n"),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(name, content, 0o600); err != nil {
+			listener.Close()
+			return nil, err
+		}
+	}
+
+	s.config = &ssh.ServerConfig{
+		PublicKeyCallback: func(meta ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if meta.User() == User && bytes.Equal(key.Marshal(), authorizedKey.Marshal()) {
+				return &ssh.Permissions{}, nil
+			}
+			return nil, fmt.Errorf("unknown public key for %q", meta.User())
+		},
+	}
+	s.config.AddHostKey(hostSigner)
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// Address returns the host and port the server listens on.
+func (s *Server) Address() string {
+	return net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+}
+
+// Forwarded returns the addresses dialed through the server so far.
+func (s *Server) Forwarded() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.forwarded...)
+}
+
+// Close stops the server and closes every connection.
+func (s *Server) Close() error {
+	err := s.listener.Close()
+
+	s.mu.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.track(conn, true)
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer s.track(conn, false)
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) track(conn net.Conn, add bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if add {
+		s.conns[conn] = struct{}{}
+	} else {
+		delete(s.conns, conn)
+		conn.Close()
+	}
+}
+
+// directTCPIP is the payload of a "direct-tcpip" channel request. (RFC 4254, 7.2)
+type directTCPIP struct {
+	Host       string
+	Port       uint32
+	OriginHost string
+	OriginPort uint32
+}
+
+func (s *Server) handle(conn net.Conn) {
+	sshConn, channels, requests, err := ssh.NewServerConn(conn, s.config)
+	if err != nil {
+		return
+	}
+	defer sshConn.Close()
+	go ssh.DiscardRequests(requests)
+
+	for request := range channels {
+		if request.ChannelType() != "direct-tcpip" {
+			_ = request.Reject(ssh.UnknownChannelType, "only port forwarding is supported")
+			continue
+		}
+
+		var payload directTCPIP
+		if err := ssh.Unmarshal(request.ExtraData(), &payload); err != nil {
+			_ = request.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+
+		address := net.JoinHostPort(payload.Host, strconv.Itoa(int(payload.Port)))
+		target, err := net.Dial("tcp", address)
+		if err != nil {
+			_ = request.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+
+		channel, channelRequests, err := request.Accept()
+		if err != nil {
+			target.Close()
+			continue
+		}
+		go ssh.DiscardRequests(channelRequests)
+
+		s.mu.Lock()
+		s.forwarded = append(s.forwarded, address)
+		s.mu.Unlock()
+
+		go pipe(channel, target)
+	}
+}
+
+// pipe copies data in both directions until either side is closed.
+func pipe(channel ssh.Channel, target net.Conn) {
+	var once sync.Once
+	closeBoth := func() {
+		channel.Close()
+		target.Close()
+	}
+
+	go func() {
+		_, _ = io.Copy(channel, target)
+		once.Do(closeBoth)
+	}()
+	_, _ = io.Copy(target, channel)
+	once.Do(closeBoth)
+}
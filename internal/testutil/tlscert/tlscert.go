@@ -0,0 +1,158 @@
+// Copyright (c) 01Joseph-Hwang10
+// SPDX-License-Identifier: MPL-2.0
+
+package tlscert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/youmark/pkcs8"
+)
+
+// Password used to encrypt the client key.
+const ClientKeyPassword = "test-password"
+
+// Certificates holds paths to the PEM files generated for a test.
+type Certificates struct {
+	// CAFile contains the certificate of the CA which signed
+	// both the server and the client certificates.
+	CAFile string
+
+	// ServerFile contains the server certificate and its key,
+	// in the format expected by mongod's --tlsCertificateKeyFile.
+	ServerFile string
+
+	ClientCertificateFile  string
+	ClientKeyFile          string
+	EncryptedClientKeyFile string
+}
+
+type issued struct {
+	certificate *x509.Certificate
+	der         []byte
+	key         *ecdsa.PrivateKey
+}
+
+// Generate creates a CA, a server certificate for localhost
+// and a client certificate, and writes them into the directory.
+func Generate(dir string) (*Certificates, error) {
+	ca, err := issue(&x509.Certificate{
+		Subject:               pkix.Name{CommonName: "terraform-provider-mongodb-test-ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	server, err := issue(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: "localhost"},
+		DNSNames:    []string{"localhost"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")},
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := issue(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: "client", OrganizationalUnit: []string{"terraform-provider-mongodb-test"}},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca)
+	if err != nil {
+		return nil, err
+	}
+
+	certificates := &Certificates{
+		CAFile:                 filepath.Join(dir, "ca.pem"),
+		ServerFile:             filepath.Join(dir, "server.pem"),
+		ClientCertificateFile:  filepath.Join(dir, "client.crt"),
+		ClientKeyFile:          filepath.Join(dir, "client.key"),
+		EncryptedClientKeyFile: filepath.Join(dir, "client.encrypted.key"),
+	}
+
+	serverKey, err := encodeKey(server.key)
+	if err != nil {
+		return nil, err
+	}
+	clientKey, err := encodeKey(client.key)
+	if err != nil {
+		return nil, err
+	}
+	encryptedClientKey, err := pkcs8.ConvertPrivateKeyToPKCS8(client.key, []byte(ClientKeyPassword))
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{
+		certificates.CAFile:                 encodeCertificate(ca),
+		certificates.ServerFile:             append(encodeCertificate(server), serverKey...),
+		certificates.ClientCertificateFile:  encodeCertificate(client),
+		certificates.ClientKeyFile:          clientKey,
+		certificates.EncryptedClientKeyFile: pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: encryptedClientKey}),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(name, content, 0600); err != nil {
+			return nil, err
+		}
+	}
+
+	return certificates, nil
+}
+
+func issue(template *x509.Certificate, parent *issued) (*issued, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 62))
+	if err != nil {
+		return nil, err
+	}
+	template.SerialNumber = serial
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(24 * time.Hour)
+
+	// Self-sign if there is no parent
+	parentCertificate, parentKey := template, key
+	if parent != nil {
+		parentCertificate, parentKey = parent.certificate, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCertificate, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	return &issued{certificate: certificate, der: der, key: key}, nil
+}
+
+func encodeCertificate(i *issued) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: i.der})
+}
+
+func encodeKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
@@ -45,6 +45,12 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Close the connections opened by the provider
+	// once the server has stopped serving.
+	if err := provider.Shutdown(context.Background()); err != nil {
+		log.Printf("failed to disconnect from the MongoDB server: %s", err.Error())
+	}
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}